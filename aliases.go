@@ -34,7 +34,13 @@ func (l Aliases) Find(country, legalForm string) string {
 }
 
 // DefaultAliases is a list of country specific legal form aliases.
-var DefaultAliases = Aliases{
+//
+// It contains the maintained aliases as well as those generated from the ELF
+// code list, see generate.go. The maintained aliases take precedence.
+var DefaultAliases = elfAliases.Merge(builtinAliases)
+
+// builtinAliases are the maintained aliases of DefaultAliases.
+var builtinAliases = Aliases{
 	"*": map[string]string{
 		"limited":              "ltd",
 		"incorporated":         "inc",
@@ -908,4 +914,20 @@ var DefaultAliases = Aliases{
 		"corpkk":                  "kk",
 		"corpyk":                  "kk",
 	},
+	"KR": map[string]string{
+		"주식회사":   "jsc",
+		"㈜":      "jsc",
+		"유한회사":   "ltd",
+		"유":      "ltd",
+		"유한책임회사": "llc",
+		"합자회사":   "lp",
+		"합명회사":   "gp",
+	},
+	"CN": map[string]string{
+		"有限公司":   "ltd",
+		"有限责任公司": "ltd",
+		"有限責任公司": "ltd",
+		"股份有限公司": "jsc",
+		"股份公司":   "jsc",
+	},
 }
@@ -0,0 +1,138 @@
+package legalform
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Ambiguity describes how likely a legal form is confused with an ordinary
+// word or an initial within a company name.
+type Ambiguity int
+
+// Possible ambiguities of legal forms.
+const (
+	// AmbiguityLow legal forms are rarely anything else, e.g. "GmbH".
+	AmbiguityLow Ambiguity = iota
+	// AmbiguityMedium legal forms are short abbreviations that are rarely
+	// used as words, e.g. "AG" or "BV".
+	AmbiguityMedium
+	// AmbiguityHigh legal forms are ordinary words or initials in many
+	// languages, e.g. "AS", "Co" or "A".
+	AmbiguityHigh
+)
+
+var ambiguityNames = map[Ambiguity]string{
+	AmbiguityLow:    "low",
+	AmbiguityMedium: "medium",
+	AmbiguityHigh:   "high",
+}
+
+// String returns the English name of the ambiguity.
+func (a Ambiguity) String() string {
+	if name, ok := ambiguityNames[a]; ok {
+		return name
+	}
+	return ambiguityNames[AmbiguityLow]
+}
+
+// ambiguousKeys are legal forms of Default that are also ordinary words or
+// common abbreviations in at least one language.
+var ambiguousKeys = map[string]struct{}{
+	"ad": {}, "am": {}, "ans": {}, "as": {}, "au": {}, "ba": {}, "bo": {},
+	"br": {}, "co": {}, "da": {}, "ed": {}, "eu": {}, "fa": {}, "fab": {},
+	"fi": {}, "gag": {}, "gar": {}, "gen": {}, "if": {}, "is": {}, "ko": {},
+	"ku": {}, "ms": {}, "na": {}, "ok": {}, "op": {}, "pa": {}, "pac": {},
+	"per": {}, "ra": {}, "sal": {}, "sam": {}, "sap": {}, "sat": {}, "se": {},
+	"sec": {}, "si": {}, "sie": {}, "son": {}, "spa": {}, "ste": {}, "too": {},
+	"tu": {}, "tub": {}, "vi": {}, "vs": {}, "zoo": {},
+}
+
+// AmbiguityOf classifies the legal form by how likely it is confused with an
+// ordinary word or an initial.
+//
+// Legal forms consisting of a single character and those that are ordinary
+// words in some language have a high ambiguity. Other legal forms with up to
+// two characters have a medium ambiguity. The legal form can either be written
+// as in a company name, e.g. "A.S.", or as a key.
+func AmbiguityOf(legalForm string) Ambiguity {
+	return ambiguity(cleanKey(legalForm))
+}
+
+func ambiguity(key string) Ambiguity {
+	length := utf8.RuneCountInString(key)
+	if _, ok := ambiguousKeys[key]; ok || length == 1 {
+		return AmbiguityHigh
+	}
+	if length == 2 {
+		return AmbiguityMedium
+	}
+	return AmbiguityLow
+}
+
+// WithAmbiguityGuard only strips legal forms with a high ambiguity if there is
+// evidence that they really are a legal form:
+//
+//   - the legal form is assigned to the country of the company, e.g. "AS" for
+//     a company from Norway, but not "Co" which is used in every country,
+//   - the legal form is written in upper case while the rest of the name is
+//     not, e.g. "Foo Bar AS", but not "FOO BAR AS" or "Foo Bar As",
+//   - the legal form contains punctuation, e.g. "A.S." or "Co.", or is
+//     enclosed in brackets.
+//
+// Otherwise the next shorter legal form is stripped, if any. Legal forms with
+// a low or medium ambiguity are always stripped.
+//
+// The guard applies to Strip, StripForCountry, StripBatch, Explain and
+// ExplainForCountry without WithStripMiddle. The country is only known for
+// StripForCountry, ExplainForCountry and batches.
+func WithAmbiguityGuard() MatcherOption {
+	return func(m *Matcher) {
+		m.guard = true
+	}
+}
+
+// guardedSuffixStart returns the index of the first token of the longest legal
+// form at the end of the tokens like suffixStart, but skips legal forms with a
+// high ambiguity unless there is evidence for them. The buf is used to collect
+// the candidates and may be nil.
+func guardedSuffixStart(idx index, countries countryIndex, country, fullName string, tokens []token, cleanTokens []string, buf []int) int {
+	if len(cleanTokens) < 2 {
+		return len(cleanTokens)
+	}
+	starts := idx.suffixes(cleanTokens[1:], buf[:0])
+	for i := len(starts) - 1; i >= 0; i-- {
+		if start := starts[i] + 1; !guarded(countries, country, fullName, tokens, cleanTokens, start) {
+			return start
+		}
+	}
+	return len(cleanTokens)
+}
+
+// guarded checks if the legal form starting at the token is skipped by the
+// ambiguity guard, i.e. it has a high ambiguity and there is no evidence for
+// it.
+func guarded(countries countryIndex, country, fullName string, tokens []token, cleanTokens []string, start int) bool {
+	key := strings.Join(cleanTokens[start:], "")
+	return ambiguity(key) == AmbiguityHigh &&
+		(country == "" || !countries.specific(country, key)) &&
+		!hasLegalFormEvidence(join(tokens[start:], fullName), fullName)
+}
+
+// hasLegalFormEvidence checks if the way the legal form is written indicates
+// that it is a legal form and not an ordinary word.
+func hasLegalFormEvidence(legalForm, fullName string) bool {
+	if strings.ContainsAny(legalForm, ".()（）") || isEnclosed(legalForm) {
+		return true
+	}
+	letters := 0
+	for _, r := range legalForm {
+		if unicode.IsLower(r) {
+			return false
+		}
+		if unicode.IsUpper(r) {
+			letters++
+		}
+	}
+	return letters > 1 && strings.ContainsFunc(fullName, unicode.IsLower)
+}
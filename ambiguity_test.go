@@ -0,0 +1,91 @@
+package legalform_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestAmbiguityOf(t *testing.T) {
+	cases := []struct {
+		legalForm string
+		expected  legalform.Ambiguity
+	}{
+		{"GmbH", legalform.AmbiguityLow},
+		{"GmbH & Co. KG", legalform.AmbiguityLow},
+		{"Ltd.", legalform.AmbiguityLow},
+		{"AG", legalform.AmbiguityMedium},
+		{"B.V.", legalform.AmbiguityMedium},
+		{"AS", legalform.AmbiguityHigh},
+		{"A.S.", legalform.AmbiguityHigh},
+		{"Co.", legalform.AmbiguityHigh},
+		{"a", legalform.AmbiguityHigh},
+		{"株", legalform.AmbiguityHigh},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			assert.Equal(t, c.expected, legalform.AmbiguityOf(c.legalForm))
+		})
+	}
+
+	assert.Equal(t, "high", legalform.AmbiguityHigh.String())
+	assert.Equal(t, "low", legalform.Ambiguity(42).String())
+}
+
+func TestMatcherWithAmbiguityGuard(t *testing.T) {
+	cases := []struct {
+		country             string
+		input               string
+		expectedCompanyName string
+		expectedLegalForm   string
+	}{
+		{"", "Foo Bar as", "Foo Bar as", ""},
+		{"", "Foo Bar As", "Foo Bar As", ""},
+		{"", "FOO BAR AS", "FOO BAR AS", ""},
+		{"", "Foo Bar AS", "Foo Bar", "AS"},
+		{"", "Foo Bar A.S.", "Foo Bar", "A.S."},
+		{"", "Foo Bar a.s.", "Foo Bar", "a.s."},
+		{"NO", "FOO BAR AS", "FOO BAR", "AS"},
+		{"no", "Foo Bar as", "Foo Bar", "as"},
+		{"DE", "Foo Bar as", "Foo Bar as", ""},
+		{"ZZ", "Foo Bar co", "Foo Bar co", ""},
+		{"DE", "Tom And Co", "Tom And Co", ""},
+		{"", "Example Co", "Example Co", ""},
+		{"", "Example Co.", "Example", "Co."},
+		{"", "Example GmbH", "Example", "GmbH"},
+		{"", "EXAMPLE GMBH", "EXAMPLE", "GMBH"},
+		{"", "example ag", "example", "ag"},
+		{"", "Example Co Ltd", "Example", "Co Ltd"},
+	}
+
+	matcher := legalform.NewMatcher(legalform.Default, legalform.WithAmbiguityGuard())
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			var actualCompany, actualLegalForm string
+			if c.country == "" {
+				actualCompany, actualLegalForm = matcher.Strip(c.input)
+			} else {
+				actualCompany, actualLegalForm = matcher.StripForCountry(c.country, c.input)
+			}
+			assert.Equal(t, c.expectedCompanyName, actualCompany)
+			assert.Equal(t, c.expectedLegalForm, actualLegalForm)
+
+			results, err := matcher.StripBatch(context.Background(), []legalform.Record{{FullName: c.input, Country: c.country}})
+			assert.NoError(t, err)
+			assert.Equal(t, c.expectedCompanyName, results[0].Name)
+			assert.Equal(t, c.expectedLegalForm, results[0].LegalForm)
+		})
+	}
+
+	explanation := matcher.Explain("Foo Bar As")
+	assert.Equal(t, "Foo Bar As", explanation.Name)
+	assert.Equal(t, "", explanation.LegalForm)
+
+	actualCompany, actualLegalForm := legalform.DefaultMatcher().Strip("Foo Bar as")
+	assert.Equal(t, "Foo Bar", actualCompany)
+	assert.Equal(t, "as", actualLegalForm)
+}
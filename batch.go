@@ -0,0 +1,239 @@
+package legalform
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// Record is a single company name to process in a batch.
+type Record struct {
+	// FullName is the full company name including its legal form.
+	FullName string
+	// Country is the optional ISO country code of the company. It is used to
+	// find the alias of the legal form and, if enabled, to restrict the legal
+	// forms to those of the country.
+	Country string
+}
+
+// Result is the result of processing a single Record in a batch.
+type Result struct {
+	Record
+	// Index is the position of the record within the input.
+	Index int
+	// Name is the plain company name without the legal form.
+	Name string
+	// LegalForm is the legal form as it was written in the full company name.
+	LegalForm string
+	// Remainder is everything after the legal form. It is only set if
+	// WithStripMiddle was used.
+	Remainder string
+	// Alias is the alias of the legal form for the country of the record. It
+	// is empty if no legal form was found.
+	Alias string
+	// Confidence rates how certain the legal form really is a legal form
+	// between 0 and 1. It takes the length and the ambiguity of the legal
+	// form, the way it was written, whether it is used in the record's country
+	// and the length of the remaining name into account. It is 0 if no legal
+	// form was found.
+	Confidence float64
+}
+
+// BatchOption configures the processing of a batch.
+type BatchOption func(*batchConfig)
+
+type batchConfig struct {
+	workers           int
+	middle            bool
+	restrictToCountry bool
+}
+
+// WithWorkers sets the number of records that are processed in parallel. It
+// defaults to GOMAXPROCS.
+func WithWorkers(workers int) BatchOption {
+	return func(c *batchConfig) {
+		c.workers = max(workers, 1)
+	}
+}
+
+// WithStripMiddle searches the legal form anywhere in the full company name
+// like StripMiddle instead of only at the end.
+func WithStripMiddle() BatchOption {
+	return func(c *batchConfig) {
+		c.middle = true
+	}
+}
+
+// WithCountryRestriction only considers the legal forms of the record's
+// country like StripForCountry.
+func WithCountryRestriction() BatchOption {
+	return func(c *batchConfig) {
+		c.restrictToCountry = true
+	}
+}
+
+func newBatchConfig(opts []BatchOption) batchConfig {
+	c := batchConfig{workers: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// StripBatch processes all records in parallel and returns their results in
+// the same order.
+//
+// If the context is cancelled before all records were processed, then the
+// results processed so far are returned together with the context's error.
+func (m *Matcher) StripBatch(ctx context.Context, records []Record, opts ...BatchOption) ([]Result, error) {
+	results := make([]Result, 0, len(records))
+	for result, err := range m.StripStream(ctx, slices.Values(records), opts...) {
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// StripStream processes the records in parallel and yields their results in
+// the same order as the records.
+//
+// Only a limited number of records is read ahead, hence arbitrarily large
+// inputs can be processed. The records are read from a separate goroutine.
+//
+// If the context is cancelled, then the context's error is yielded as the last
+// element. Stopping the iteration early stops reading further records.
+func (m *Matcher) StripStream(ctx context.Context, records iter.Seq[Record], opts ...BatchOption) iter.Seq2[Result, error] {
+	return func(yield func(Result, error) bool) {
+		cfg := newBatchConfig(opts)
+		wg := sync.WaitGroup{}
+		defer wg.Wait()
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		jobs := make(chan batchJob, cfg.workers)
+		pending := make(chan chan Result, 4*cfg.workers)
+		var produceErr error
+		wg.Add(cfg.workers + 1)
+		go func() {
+			defer wg.Done()
+			produceErr = m.produce(ctx, records, jobs, pending)
+			close(jobs)
+			close(pending)
+		}()
+		for range cfg.workers {
+			go func() {
+				defer wg.Done()
+				for job := range jobs {
+					job.result <- m.process(cfg, job.index, job.record)
+				}
+			}()
+		}
+
+		for result := range pending {
+			select {
+			case r := <-result:
+				if !yield(r, nil) {
+					return
+				}
+			case <-ctx.Done():
+				yield(Result{}, ctx.Err())
+				return
+			}
+		}
+		if produceErr != nil {
+			yield(Result{}, produceErr)
+		}
+	}
+}
+
+// StripChannel processes the records from the channel in parallel and sends
+// their results in the same order to the returned channel.
+//
+// The returned channel is closed after all records were processed or when the
+// context was cancelled.
+func (m *Matcher) StripChannel(ctx context.Context, records <-chan Record, opts ...BatchOption) <-chan Result {
+	results := make(chan Result)
+	go func() {
+		defer close(results)
+		seq := func(yield func(Record) bool) {
+			for {
+				select {
+				case record, ok := <-records:
+					if !ok || !yield(record) {
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+		for result, err := range m.StripStream(ctx, seq, opts...) {
+			if err != nil {
+				return
+			}
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return results
+}
+
+type batchJob struct {
+	index  int
+	record Record
+	result chan Result
+}
+
+// produce reads the records and distributes them to the workers. The result
+// channel of each record is added to pending in the order of the records.
+//
+// If the context was cancelled before all records were read, then the
+// context's error is returned.
+func (m *Matcher) produce(ctx context.Context, records iter.Seq[Record], jobs chan<- batchJob, pending chan<- chan Result) error {
+	i := 0
+	for record := range records {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		job := batchJob{index: i, record: record, result: make(chan Result, 1)}
+		select {
+		case pending <- job.result:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		select {
+		case jobs <- job:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		i++
+	}
+	return nil
+}
+
+func (m *Matcher) process(cfg batchConfig, i int, record Record) Result {
+	var idx index = m
+	if cfg.restrictToCountry {
+		idx = m.forCountry(record.Country)
+	}
+
+	result := Result{Record: record, Index: i}
+	if cfg.middle {
+		result.Name, result.LegalForm, result.Remainder = stripMiddle(idx, record.FullName)
+		result.Name = m.trim(result.Name)
+	} else {
+		result.Name, result.LegalForm = m.strip(idx, record.Country, record.FullName)
+	}
+	if result.LegalForm != "" {
+		result.Alias = m.Alias(record.Country, result.LegalForm)
+	}
+	result.Confidence = m.confidence(result)
+	return result
+}
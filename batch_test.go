@@ -0,0 +1,165 @@
+package legalform_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestStripBatch(t *testing.T) {
+	records := []legalform.Record{
+		{FullName: "Example GmbH", Country: "DE"},
+		{FullName: "Example AG", Country: "US"},
+		{FullName: "Example Limited", Country: "UK"},
+		{FullName: "Example"},
+		{FullName: "Example GmbH & Co. KG Some Street", Country: "DE"},
+		{FullName: "Example AG", Country: "GB"},
+	}
+	cases := []struct {
+		opts     []legalform.BatchOption
+		expected []legalform.Result
+	}{
+		{
+			opts: []legalform.BatchOption{legalform.WithWorkers(2)},
+			expected: []legalform.Result{
+				{Record: records[0], Index: 0, Name: "Example", LegalForm: "GmbH", Alias: "gmbh"},
+				{Record: records[1], Index: 1, Name: "Example", LegalForm: "AG", Alias: "ag"},
+				{Record: records[2], Index: 2, Name: "Example", LegalForm: "Limited", Alias: "ltd"},
+				{Record: records[3], Index: 3, Name: "Example"},
+				{Record: records[4], Index: 4, Name: "Example GmbH & Co. KG Some Street"},
+				{Record: records[5], Index: 5, Name: "Example", LegalForm: "AG", Alias: "ag"},
+			},
+		},
+		{
+			opts: []legalform.BatchOption{legalform.WithStripMiddle(), legalform.WithCountryRestriction()},
+			expected: []legalform.Result{
+				{Record: records[0], Index: 0, Name: "Example", LegalForm: "GmbH", Alias: "gmbh"},
+				{Record: records[1], Index: 1, Name: "Example AG"},
+				{Record: records[2], Index: 2, Name: "Example", LegalForm: "Limited", Alias: "ltd"},
+				{Record: records[3], Index: 3, Name: "Example"},
+				{Record: records[4], Index: 4, Name: "Example", LegalForm: "GmbH & Co. KG", Remainder: "Some Street", Alias: "gmbhcokg"},
+				{Record: records[5], Index: 5, Name: "Example AG"},
+			},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			actual, err := legalform.DefaultMatcher().StripBatch(context.Background(), records, c.opts...)
+			assert.NoError(t, err)
+			// The confidence is covered by TestConfidence.
+			for i := range actual {
+				assert.Equal(t, actual[i].LegalForm != "", actual[i].Confidence > 0)
+				actual[i].Confidence = 0
+			}
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func TestStripStreamPreservesOrder(t *testing.T) {
+	seq := func(yield func(legalform.Record) bool) {
+		for i := range 10000 {
+			if !yield(legalform.Record{FullName: fmt.Sprintf("Example %v Inc.", i)}) {
+				return
+			}
+		}
+	}
+
+	i := 0
+	for result, err := range legalform.DefaultMatcher().StripStream(context.Background(), seq, legalform.WithWorkers(8)) {
+		assert.NoError(t, err)
+		assert.Equal(t, i, result.Index)
+		assert.Equal(t, fmt.Sprintf("Example %v", i), result.Name)
+		i++
+	}
+	assert.Equal(t, 10000, i)
+}
+
+func TestStripStreamStopsEarly(t *testing.T) {
+	read := 0
+	seq := func(yield func(legalform.Record) bool) {
+		for {
+			read++
+			if !yield(legalform.Record{FullName: "Example Inc."}) {
+				return
+			}
+		}
+	}
+
+	i := 0
+	for range legalform.DefaultMatcher().StripStream(context.Background(), seq, legalform.WithWorkers(2)) {
+		i++
+		if i == 10 {
+			break
+		}
+	}
+	assert.Equal(t, 10, i)
+	assert.Less(t, read, 100)
+}
+
+func TestStripStreamCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	seq := func(yield func(legalform.Record) bool) {
+		for {
+			if !yield(legalform.Record{FullName: "Example Inc."}) {
+				return
+			}
+		}
+	}
+
+	var lastErr error
+	i := 0
+	for _, err := range legalform.DefaultMatcher().StripStream(ctx, seq) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		i++
+		if i == 10 {
+			cancel()
+		}
+	}
+	assert.ErrorIs(t, lastErr, context.Canceled)
+}
+
+func TestStripBatchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := legalform.DefaultMatcher().StripBatch(ctx, []legalform.Record{{FullName: "Example Inc."}})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestStripChannel(t *testing.T) {
+	records := make(chan legalform.Record)
+	go func() {
+		defer close(records)
+		for i := range 100 {
+			records <- legalform.Record{FullName: fmt.Sprintf("Example %v GmbH", i), Country: "DE"}
+		}
+	}()
+
+	i := 0
+	for result := range legalform.DefaultMatcher().StripChannel(context.Background(), records) {
+		assert.Equal(t, i, result.Index)
+		assert.Equal(t, fmt.Sprintf("Example %v", i), result.Name)
+		assert.Equal(t, "gmbh", result.Alias)
+		i++
+	}
+	assert.Equal(t, 100, i)
+}
+
+func TestStripChannelCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	records := make(chan legalform.Record)
+	results := legalform.DefaultMatcher().StripChannel(ctx, records)
+	records <- legalform.Record{FullName: "Example Inc."}
+	<-results
+	cancel()
+	for range results {
+	}
+}
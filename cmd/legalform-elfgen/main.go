@@ -0,0 +1,195 @@
+// Command legalform-elfgen generates the legal forms and aliases from a local
+// copy of the ISO 20275 entity legal form (ELF) code list CSV published by
+// GLEIF.
+//
+// A report of the added, removed and conflicting entries compared to the
+// default legal forms and aliases is written to stderr. Using -forms, -aliases
+// and -elfs, the legal forms, the aliases and the entries of the ELF code list
+// are generated as Go variables with the given names and written to the output
+// file. Without any of them, only the report is written. Using -skip-missing,
+// nothing is generated if the ELF code list does not exist, e.g. when running
+// go generate on a clean checkout.
+//
+// Usage:
+//
+//	legalform-elfgen -in elf-code-list.csv
+//	legalform-elfgen -in elf-code-list.csv -skip-missing -forms elfLegalForms -aliases elfAliases -out legalforms_elf.go
+//	legalform-elfgen -in elf-code-list.csv -package mypkg -forms ELFLegalForms -aliases ELFAliases -out legalforms_elf.go
+//	legalform-elfgen -in elf-code-list.csv -package mypkg -elfs elfs -out elfs.go
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func main() {
+	in := flag.String("in", "", "path to the ELF code list CSV")
+	out := flag.String("out", "", "path to the generated Go file, defaults to stdout")
+	pkg := flag.String("package", "legalform", "package name of the generated Go file")
+	formsVar := flag.String("forms", "", "variable name of the generated legal forms")
+	aliasesVar := flag.String("aliases", "", "variable name of the generated aliases")
+	elfsVar := flag.String("elfs", "", "variable name of the generated ELF code list entries")
+	skipMissing := flag.Bool("skip-missing", false, "skip the generation if the ELF code list does not exist")
+	flag.Parse()
+
+	if err := run(*in, *out, *skipMissing, generator{
+		pkg:        *pkg,
+		formsVar:   *formsVar,
+		aliasesVar: *aliasesVar,
+		elfsVar:    *elfsVar,
+	}); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(in, out string, skipMissing bool, g generator) error {
+	if in == "" {
+		return fmt.Errorf("missing input file, use -in")
+	}
+	f, err := os.Open(in)
+	if skipMissing && errors.Is(err, fs.ErrNotExist) {
+		fmt.Fprintf(os.Stderr, "skipping generation, %v does not exist\n", in)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	elfs, err := legalform.ParseELFCSV(f)
+	if err != nil {
+		return err
+	}
+	result := legalform.ImportELF(elfs...)
+
+	if !g.empty() {
+		src, err := g.source(result, elfs)
+		if err != nil {
+			return err
+		}
+		if out == "" {
+			_, err = os.Stdout.Write(src)
+		} else {
+			err = os.WriteFile(out, src, 0o600)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	report(os.Stderr, result)
+	return nil
+}
+
+type generator struct {
+	pkg        string
+	formsVar   string
+	aliasesVar string
+	elfsVar    string
+}
+
+// empty checks if the generator does not generate any variable.
+func (g generator) empty() bool {
+	return g.formsVar == "" && g.aliasesVar == "" && g.elfsVar == ""
+}
+
+func (g generator) source(result legalform.ELFImport, elfs []legalform.ELF) ([]byte, error) {
+	qualifier := "legalform."
+	if g.pkg == "legalform" {
+		qualifier = ""
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by legalform-elfgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %v\n\n", g.pkg)
+	if qualifier != "" {
+		fmt.Fprintf(&b, "import legalform \"github.com/tilotech/go-company-legal-form\"\n\n")
+	}
+
+	if g.formsVar != "" {
+		fmt.Fprintf(&b, "// %v contains the legal forms of the ELF code list.\n", g.formsVar)
+		fmt.Fprintf(&b, "var %v = %vLegalForms{\n", g.formsVar, qualifier)
+		for _, key := range slices.Sorted(maps.Keys(result.LegalForms)) {
+			fmt.Fprintf(&b, "%q: struct{}{},\n", key)
+		}
+		fmt.Fprintf(&b, "}\n\n")
+	}
+
+	if g.aliasesVar != "" {
+		fmt.Fprintf(&b, "// %v contains the country specific aliases of the ELF code list.\n", g.aliasesVar)
+		fmt.Fprintf(&b, "var %v = %vAliases{\n", g.aliasesVar, qualifier)
+		for _, country := range slices.Sorted(maps.Keys(result.Aliases)) {
+			fmt.Fprintf(&b, "%q: map[string]string{\n", country)
+			for _, key := range slices.Sorted(maps.Keys(result.Aliases[country])) {
+				fmt.Fprintf(&b, "%q: %q,\n", key, result.Aliases[country][key])
+			}
+			fmt.Fprintf(&b, "},\n")
+		}
+		fmt.Fprintf(&b, "}\n\n")
+	}
+
+	if g.elfsVar != "" {
+		fmt.Fprintf(&b, "// %v contains the entries of the ELF code list.\n", g.elfsVar)
+		fmt.Fprintf(&b, "var %v = []%vELF{\n", g.elfsVar, qualifier)
+		for _, elf := range elfs {
+			writeELF(&b, elf)
+		}
+		fmt.Fprintf(&b, "}\n")
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// writeELF writes the entity legal form as a composite literal, omitting empty
+// fields.
+func writeELF(b *bytes.Buffer, elf legalform.ELF) {
+	fmt.Fprintf(b, "{Code: %q, Country: %q", elf.Code, elf.Country)
+	if elf.Subdivision != "" {
+		fmt.Fprintf(b, ", Subdivision: %q", elf.Subdivision)
+	}
+	if elf.Name != "" {
+		fmt.Fprintf(b, ", Name: %q", elf.Name)
+	}
+	if elf.TransliteratedName != "" {
+		fmt.Fprintf(b, ", TransliteratedName: %q", elf.TransliteratedName)
+	}
+	if len(elf.Abbreviations) > 0 {
+		fmt.Fprintf(b, ", Abbreviations: %#v", elf.Abbreviations)
+	}
+	fmt.Fprintf(b, ", Status: %q},\n", elf.Status)
+}
+
+func report(w io.Writer, result legalform.ELFImport) {
+	added, removed := result.LegalForms.Diff(legalform.Default)
+	reportSection(w, "legal forms", added, removed)
+
+	added, removed = result.Aliases.Diff(legalform.DefaultAliases)
+	reportSection(w, "aliases", added, removed)
+
+	fmt.Fprintf(w, "conflicts: %v\n", len(result.Conflicts))
+	for _, conflict := range result.Conflicts {
+		fmt.Fprintf(w, "! %v\n", conflict)
+	}
+}
+
+func reportSection(w io.Writer, name string, added, removed []string) {
+	fmt.Fprintf(w, "%v: %v added, %v removed\n", name, len(added), len(removed))
+	for _, entry := range added {
+		fmt.Fprintf(w, "+ %v\n", entry)
+	}
+	for _, entry := range removed {
+		fmt.Fprintf(w, "- %v\n", entry)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestSource(t *testing.T) {
+	elfs := []legalform.ELF{
+		{Code: "2HBR", Country: "DE", Name: "Gesellschaft mit beschränkter Haftung", Abbreviations: []string{"GmbH"}, Status: legalform.ELFStatusActive},
+	}
+	result := legalform.ImportELF(elfs...)
+
+	src, err := generator{pkg: "legalform", elfsVar: "defaultELFs"}.source(result, elfs)
+	assert.NoError(t, err)
+	expected := `// Code generated by legalform-elfgen. DO NOT EDIT.
+
+package legalform
+
+// defaultELFs contains the entries of the ELF code list.
+var defaultELFs = []ELF{
+	{Code: "2HBR", Country: "DE", Name: "Gesellschaft mit beschränkter Haftung", Abbreviations: []string{"GmbH"}, Status: "ACTV"},
+}
+`
+	assert.Equal(t, expected, string(src))
+
+	src, err = generator{pkg: "custom", formsVar: "Forms", aliasesVar: "Aliases"}.source(result, elfs)
+	assert.NoError(t, err)
+	assert.Contains(t, string(src), "var Forms = legalform.LegalForms{\n\t\"gesellschaftmitbeschrankterhaftung\": struct{}{},\n\t\"gmbh\":")
+	assert.Contains(t, string(src), "var Aliases = legalform.Aliases{")
+	assert.NotContains(t, string(src), "[]legalform.ELF")
+}
+
+func TestRunReportOnly(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "elf-code-list.csv")
+	out := filepath.Join(dir, "legalforms_elf.go")
+	csv := "ELF Code,Country Code (ISO 3166-1),Entity Legal Form name Local name,Abbreviations Local language,ELF Status ACTV/INAC\n" +
+		"2HBR,DE,Gesellschaft mit beschränkter Haftung,GmbH,ACTV\n"
+	assert.NoError(t, os.WriteFile(in, []byte(csv), 0o600))
+
+	assert.NoError(t, run(in, out, false, generator{pkg: "legalform"}))
+	assert.NoFileExists(t, out)
+
+	assert.NoError(t, run(in, out, false, generator{pkg: "legalform", formsVar: "forms"}))
+	assert.FileExists(t, out)
+}
+
+func TestRunSkipMissing(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "elf-code-list.csv")
+	out := filepath.Join(dir, "legalforms_elf.go")
+	g := generator{pkg: "legalform", formsVar: "forms"}
+
+	assert.NoError(t, run(in, out, true, g))
+	assert.NoFileExists(t, out)
+	assert.Error(t, run(in, out, false, g))
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sync/atomic"
+
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+// server holds the current matcher and ELF codes and serves the HTTP API.
+type server struct {
+	matcher  legalform.AtomicMatcher
+	elfCodes atomic.Pointer[legalform.ELFCodes]
+	ready    atomic.Bool
+	maxBody  int64
+	maxBatch int
+}
+
+func newServer(maxBody int64, maxBatch int) *server {
+	return &server{maxBody: maxBody, maxBatch: maxBatch}
+}
+
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/strip", s.handleStrip)
+	mux.HandleFunc("POST /v1/strip/batch", s.handleStripBatch)
+	mux.HandleFunc("GET /v1/alias", s.handleAlias)
+	mux.HandleFunc("GET /v1/metadata", s.handleMetadata)
+	mux.HandleFunc("GET /healthz", s.handleHealth)
+	mux.HandleFunc("GET /readyz", s.handleReady)
+	return http.MaxBytesHandler(mux, s.maxBody)
+}
+
+type stripOptions struct {
+	// Middle searches the legal form anywhere in the name.
+	Middle bool `json:"middle,omitempty"`
+	// Restrict only considers legal forms of the country.
+	Restrict bool `json:"restrict,omitempty"`
+}
+
+func (o stripOptions) batchOptions() []legalform.BatchOption {
+	opts := []legalform.BatchOption{}
+	if o.Middle {
+		opts = append(opts, legalform.WithStripMiddle())
+	}
+	if o.Restrict {
+		opts = append(opts, legalform.WithCountryRestriction())
+	}
+	return opts
+}
+
+type record struct {
+	Name    string `json:"name"`
+	Country string `json:"country,omitempty"`
+}
+
+type stripRequest struct {
+	record
+	stripOptions
+}
+
+type batchRequest struct {
+	Records []record `json:"records"`
+	stripOptions
+}
+
+type stripResult struct {
+	Name      string `json:"name"`
+	LegalForm string `json:"legalForm"`
+	Remainder string `json:"remainder,omitempty"`
+	Alias     string `json:"alias,omitempty"`
+	// Confidence is rounded to three decimals.
+	Confidence float64 `json:"confidence"`
+}
+
+type batchResponse struct {
+	Results []stripResult `json:"results"`
+}
+
+func (s *server) handleStrip(w http.ResponseWriter, r *http.Request) {
+	req := stripRequest{}
+	if !decode(w, r, &req) {
+		return
+	}
+	results, ok := s.strip(w, r, []record{req.record}, req.stripOptions)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, results[0])
+}
+
+func (s *server) handleStripBatch(w http.ResponseWriter, r *http.Request) {
+	req := batchRequest{}
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Records) > s.maxBatch {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("too many records, at most %v are allowed", s.maxBatch))
+		return
+	}
+	results, ok := s.strip(w, r, req.Records, req.stripOptions)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, batchResponse{Results: results})
+}
+
+func (s *server) strip(w http.ResponseWriter, r *http.Request, records []record, opts stripOptions) ([]stripResult, bool) {
+	batch := make([]legalform.Record, len(records))
+	for i, rec := range records {
+		batch[i] = legalform.Record{FullName: rec.Name, Country: rec.Country}
+	}
+	m, ok := s.loadMatcher(w)
+	if !ok {
+		return nil, false
+	}
+	results, err := m.StripBatch(r.Context(), batch, opts.batchOptions()...)
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, err.Error())
+		return nil, false
+	}
+
+	response := make([]stripResult, len(results))
+	for i, result := range results {
+		response[i] = stripResult{
+			Name:       result.Name,
+			LegalForm:  result.LegalForm,
+			Remainder:  result.Remainder,
+			Alias:      result.Alias,
+			Confidence: math.Round(result.Confidence*1000) / 1000,
+		}
+	}
+	return response, true
+}
+
+// loadMatcher returns the current matcher. If no matcher was loaded yet, an
+// error response is written and false is returned.
+func (s *server) loadMatcher(w http.ResponseWriter) (*legalform.Matcher, bool) {
+	m := s.matcher.Load()
+	if m == nil {
+		writeError(w, http.StatusServiceUnavailable, "legal forms are not loaded yet")
+		return nil, false
+	}
+	return m, true
+}
+
+type aliasResponse struct {
+	Alias string `json:"alias"`
+}
+
+func (s *server) handleAlias(w http.ResponseWriter, r *http.Request) {
+	country, legalForm, ok := legalFormQuery(w, r)
+	if !ok {
+		return
+	}
+	m, ok := s.loadMatcher(w)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, aliasResponse{Alias: m.Alias(country, legalForm)})
+}
+
+type metadataResponse struct {
+	Found            bool       `json:"found"`
+	Key              string     `json:"key,omitempty"`
+	Countries        []string   `json:"countries,omitempty"`
+	Name             string     `json:"name,omitempty"`
+	Description      string     `json:"description,omitempty"`
+	Category         string     `json:"category,omitempty"`
+	LimitedLiability bool       `json:"limitedLiability"`
+	ELF              []elfEntry `json:"elf,omitempty"`
+}
+
+type elfEntry struct {
+	Code   string `json:"code"`
+	Status string `json:"status"`
+}
+
+func (s *server) handleMetadata(w http.ResponseWriter, r *http.Request) {
+	country, legalForm, ok := legalFormQuery(w, r)
+	if !ok {
+		return
+	}
+
+	m, ok := s.loadMatcher(w)
+	if !ok {
+		return
+	}
+
+	// The loaded aliases resolve legal forms that are unknown to the defaults.
+	alias := m.Alias(country, legalForm)
+	response := metadataResponse{}
+	form, found := legalform.DefaultRegistry.Find(country, legalForm)
+	if !found {
+		form, found = legalform.DefaultRegistry.Find(country, alias)
+	}
+	if found {
+		response = metadataResponse{
+			Found:            true,
+			Key:              form.Key,
+			Countries:        form.Countries,
+			Name:             form.Name,
+			Description:      form.Description,
+			Category:         form.Category.String(),
+			LimitedLiability: form.LimitedLiability,
+		}
+	}
+	elfCodes := *s.elfCodes.Load()
+	elfs := elfCodes.Find(country, legalForm)
+	if len(elfs) == 0 {
+		elfs = elfCodes.Find(country, alias)
+	}
+	for _, elf := range elfs {
+		response.ELF = append(response.ELF, elfEntry{Code: elf.Code, Status: string(elf.Status)})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// legalFormQuery reads the country and the legal form from the query
+// parameters. The legal form is required.
+func legalFormQuery(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	query := r.URL.Query()
+	legalForm := query.Get("legalForm")
+	if legalForm == "" {
+		writeError(w, http.StatusBadRequest, "missing query parameter legalForm")
+		return "", "", false
+	}
+	return query.Get("country"), legalForm, true
+}
+
+type statusResponse struct {
+	Status string `json:"status"`
+}
+
+func (s *server) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, statusResponse{Status: "ok"})
+}
+
+func (s *server) handleReady(w http.ResponseWriter, _ *http.Request) {
+	if !s.ready.Load() {
+		writeJSON(w, http.StatusServiceUnavailable, statusResponse{Status: "not ready"})
+		return
+	}
+	writeJSON(w, http.StatusOK, statusResponse{Status: "ready"})
+}
+
+// decode reads the JSON request body into v. If that fails, an error response
+// is written and false is returned.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(v)
+	if err == nil {
+		return true
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body too large, at most %v bytes are allowed", maxBytesErr.Limit))
+		return false
+	}
+	writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+	return false
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, errorResponse{Error: msg})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(v)
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandlers(t *testing.T) {
+	s := newServer(1024, 2)
+	assert.NoError(t, s.load("", "", ""))
+	handler := s.routes()
+
+	cases := []struct {
+		method   string
+		path     string
+		body     string
+		status   int
+		expected string
+	}{
+		{
+			method:   http.MethodPost,
+			path:     "/v1/strip",
+			body:     `{"name":"Example GmbH","country":"DE"}`,
+			status:   http.StatusOK,
+			expected: `{"name":"Example","legalForm":"GmbH","alias":"gmbh","confidence":0.9}`,
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/v1/strip",
+			body:     `{"name":"Example GmbH & Co. KG Street","middle":true}`,
+			status:   http.StatusOK,
+			expected: `{"name":"Example","legalForm":"GmbH & Co. KG","remainder":"Street","alias":"gmbhcokg","confidence":0.72}`,
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/v1/strip",
+			body:     `{"name":"Example AG","country":"US","restrict":true}`,
+			status:   http.StatusOK,
+			expected: `{"name":"Example AG","legalForm":"","confidence":0}`,
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/v1/strip/batch",
+			body:     `{"records":[{"name":"Example Ltd"},{"name":"Nothing"}]}`,
+			status:   http.StatusOK,
+			expected: `{"results":[{"name":"Example","legalForm":"Ltd","alias":"ltd","confidence":0.749},{"name":"Nothing","legalForm":"","confidence":0}]}`,
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/v1/strip/batch",
+			body:     `{"records":[{"name":"A"},{"name":"B"},{"name":"C"}]}`,
+			status:   http.StatusRequestEntityTooLarge,
+			expected: `{"error":"too many records, at most 2 are allowed"}`,
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/v1/strip",
+			body:     `{"name":"` + strings.Repeat("a", 2048) + `"}`,
+			status:   http.StatusRequestEntityTooLarge,
+			expected: `{"error":"request body too large, at most 1024 bytes are allowed"}`,
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/v1/strip",
+			body:     `{"name":`,
+			status:   http.StatusBadRequest,
+			expected: `{"error":"invalid request body: unexpected EOF"}`,
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/v1/alias?country=DE&legalForm=Gesellschaft+mit+beschr%C3%A4nkter+Haftung",
+			status:   http.StatusOK,
+			expected: `{"alias":"gmbh"}`,
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/v1/alias?country=DE",
+			status:   http.StatusBadRequest,
+			expected: `{"error":"missing query parameter legalForm"}`,
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/v1/metadata?country=XX&legalForm=Foo",
+			status:   http.StatusOK,
+			expected: `{"found":false,"limitedLiability":false}`,
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/healthz",
+			status:   http.StatusOK,
+			expected: `{"status":"ok"}`,
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/readyz",
+			status:   http.StatusOK,
+			expected: `{"status":"ready"}`,
+		},
+		{
+			method: http.MethodGet,
+			path:   "/v1/strip",
+			status: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			req := httptest.NewRequest(c.method, c.path, strings.NewReader(c.body))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, c.status, rec.Code)
+			if c.expected != "" {
+				assert.JSONEq(t, c.expected, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	s := newServer(1024, 2)
+	assert.NoError(t, s.load("", "", ""))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/metadata?country=DE&legalForm=GmbH", nil)
+	rec := httptest.NewRecorder()
+	s.routes().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"found":true`)
+	assert.Contains(t, rec.Body.String(), `"key":"gmbh"`)
+	assert.Contains(t, rec.Body.String(), `"limitedLiability":true`)
+	assert.NotContains(t, rec.Body.String(), `"elf"`)
+}
+
+// The code T001 is made up for testing purposes.
+const testELFCSV = "ELF Code,Country Code (ISO 3166-1),Country sub-division code (ISO 3166-2),Entity Legal Form name Local name,Entity Legal Form name Transliterated name (per ISO 01-140-10),Abbreviations Local language,Abbreviations transliterated,ELF Status ACTV/INAC\n" +
+	"T001,DE,,Aktiengesellschaft,,AG,,ACTV\n"
+
+func TestMetadataWithLoadedData(t *testing.T) {
+	dir := t.TempDir()
+	aliasesPath := filepath.Join(dir, "aliases.json")
+	elfPath := filepath.Join(dir, "elf-code-list.csv")
+	assert.NoError(t, os.WriteFile(aliasesPath, []byte(`{"DE":{"aktges":"ag"}}`), 0o600))
+	assert.NoError(t, os.WriteFile(elfPath, []byte(testELFCSV), 0o600))
+
+	s := newServer(1024, 2)
+	assert.NoError(t, s.load("", aliasesPath, elfPath))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/metadata?country=DE&legalForm=AktGes", nil)
+	rec := httptest.NewRecorder()
+	s.routes().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"key":"ag"`)
+	assert.Contains(t, rec.Body.String(), `{"code":"T001","status":"ACTV"}`)
+
+	assert.Error(t, s.load("", "", filepath.Join(dir, "missing.csv")))
+}
+
+func TestReadyBeforeLoad(t *testing.T) {
+	s := newServer(1024, 2)
+
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	rec := httptest.NewRecorder()
+	s.routes().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/strip", strings.NewReader(`{"name":"Example GmbH"}`))
+	rec = httptest.NewRecorder()
+	s.routes().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
@@ -0,0 +1,159 @@
+// Command legalform-server serves the legal form logic as a JSON HTTP API.
+//
+// Endpoints:
+//
+//	POST /v1/strip         strips a single name
+//	POST /v1/strip/batch   strips multiple names
+//	GET  /v1/alias         returns the alias of a legal form
+//	GET  /v1/metadata      returns the metadata and ELF codes of a legal form
+//	GET  /healthz          liveness probe
+//	GET  /readyz           readiness probe
+//
+// Additional legal forms and aliases can be loaded from JSON or YAML files.
+// They are merged with the defaults and reloaded when receiving SIGHUP. The
+// metadata endpoint resolves legal forms using these aliases, but its metadata
+// always comes from DefaultRegistry. Its ELF codes come from the ELF code list
+// CSV if provided, otherwise no ELF codes are returned.
+//
+// Usage:
+//
+//	legalform-server -addr :8080 -forms custom.yaml -aliases aliases.yaml -elf elf-code-list.csv
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+type config struct {
+	addr     string
+	forms    string
+	aliases  string
+	elf      string
+	maxBody  int64
+	maxBatch int
+}
+
+func main() {
+	cfg := config{}
+	flag.StringVar(&cfg.addr, "addr", ":8080", "address to listen on")
+	flag.StringVar(&cfg.forms, "forms", "", "JSON or YAML file with additional legal forms")
+	flag.StringVar(&cfg.aliases, "aliases", "", "JSON or YAML file with additional aliases")
+	flag.StringVar(&cfg.elf, "elf", "", "ELF code list CSV as published by GLEIF")
+	flag.Int64Var(&cfg.maxBody, "max-body", 1<<20, "maximum size of a request body in bytes")
+	flag.IntVar(&cfg.maxBatch, "max-batch", 10000, "maximum number of names in a batch request")
+	flag.Parse()
+
+	if err := run(cfg); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(cfg config) error {
+	s := newServer(cfg.maxBody, cfg.maxBatch)
+	if err := s.load(cfg.forms, cfg.aliases, cfg.elf); err != nil {
+		return err
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	go func() {
+		for range hup {
+			if err := s.load(cfg.forms, cfg.aliases, cfg.elf); err != nil {
+				log.Printf("failed to reload: %v", err)
+				continue
+			}
+			log.Print("reloaded legal forms, aliases and ELF codes")
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{
+		Addr:              cfg.addr,
+		Handler:           s.routes(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      60 * time.Second,
+		IdleTimeout:       120 * time.Second,
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+	log.Printf("listening on %v", cfg.addr)
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.ready.Store(false)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+	if err := <-errs; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// load creates a new matcher from the default legal forms and aliases merged
+// with the ones from the given files and replaces the current matcher. The ELF
+// codes are read from the given ELF code list, if any.
+func (s *server) load(formsPath, aliasesPath, elfPath string) error {
+	forms := legalform.Default
+	if formsPath != "" {
+		custom, err := legalform.LoadLegalForms(formsPath)
+		if err != nil {
+			return fmt.Errorf("failed to load legal forms: %w", err)
+		}
+		forms = forms.Merge(custom)
+	}
+
+	aliases := legalform.DefaultAliases
+	if aliasesPath != "" {
+		custom, err := legalform.LoadAliases(aliasesPath)
+		if err != nil {
+			return fmt.Errorf("failed to load aliases: %w", err)
+		}
+		aliases = aliases.Merge(custom)
+	}
+
+	elfCodes := legalform.ELFCodes{}
+	if elfPath != "" {
+		var err error
+		if elfCodes, err = readELFCodes(elfPath); err != nil {
+			return fmt.Errorf("failed to load ELF codes: %w", err)
+		}
+	}
+
+	s.elfCodes.Store(&elfCodes)
+	s.matcher.Store(legalform.NewMatcher(forms, legalform.WithAliases(aliases)))
+	s.ready.Store(true)
+	return nil
+}
+
+func readELFCodes(path string) (legalform.ELFCodes, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return legalform.ReadELFCodes(f)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+// analyze reads all names and writes the most frequent unmatched suffixes per
+// country as tab separated values.
+//
+// Each country starts with a summary line containing the number of names and
+// the number of names with a legal form. It is followed by one line per suffix
+// with its number of occurrences, its cleaned key and its spellings.
+func analyze(ctx context.Context, cfg config, f format, w io.Writer) error {
+	coverage := legalform.NewCoverage(legalform.DefaultMatcher())
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		_, record, err := f.read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if record.Country == "" {
+			record.Country = cfg.country
+		}
+		coverage.Add(record.Country, record.FullName)
+	}
+
+	for _, country := range coverage.Report(cfg.coverage) {
+		name := country.Country
+		if name == "" {
+			name = "-"
+		}
+		if _, err := fmt.Fprintf(w, "%v\t%v names\t%v matched\n", name, country.Names, country.Matched); err != nil {
+			return err
+		}
+		for _, suffix := range country.Suffixes {
+			if _, err := fmt.Fprintf(w, "%v\t%v\t%v\t%v\n", name, suffix.Count, suffix.Key, strings.Join(suffix.Examples, " | ")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,349 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+// maxLineSize is the maximum size of a single line of text or NDJSON input.
+const maxLineSize = 16 * 1024 * 1024
+
+// row is a single row of the input as it was read, e.g. a line or a record.
+type row any
+
+// format reads the rows of the input and writes them together with their
+// result to the output.
+type format interface {
+	read() (row, legalform.Record, error)
+	write(row row, result legalform.Result) error
+	flush() error
+}
+
+func newFormat(cfg config, r io.Reader, w io.Writer) (format, error) {
+	switch cfg.format {
+	case "text":
+		return &textFormat{cfg: cfg, scanner: newScanner(r), w: w}, nil
+	case "csv":
+		return &csvFormat{cfg: cfg, r: csv.NewReader(r), w: csv.NewWriter(w)}, nil
+	case "ndjson":
+		return &ndjsonFormat{cfg: cfg, scanner: newScanner(r), w: w}, nil
+	}
+	return nil, fmt.Errorf("unsupported format %q", cfg.format)
+}
+
+func newScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	return scanner
+}
+
+// outputColumns returns the names of the columns or fields that are added to
+// the input.
+func outputColumns(cfg config) []string {
+	columns := []string{"company_name", "legal_form"}
+	if cfg.middle {
+		columns = append(columns, "remainder")
+	}
+	if cfg.alias {
+		columns = append(columns, "legal_form_alias")
+	}
+	return columns
+}
+
+// outputValues returns the values of the output columns for the result.
+func outputValues(cfg config, result legalform.Result) []string {
+	values := []string{result.Name, result.LegalForm}
+	if cfg.middle {
+		values = append(values, result.Remainder)
+	}
+	if cfg.alias {
+		values = append(values, result.Alias)
+	}
+	return values
+}
+
+// textFormat reads one name per line and writes the name together with the
+// output values separated by tabs.
+type textFormat struct {
+	cfg     config
+	scanner *bufio.Scanner
+	w       io.Writer
+}
+
+func (f *textFormat) read() (row, legalform.Record, error) {
+	if !f.scanner.Scan() {
+		return nil, legalform.Record{}, eof(f.scanner.Err())
+	}
+	line := f.scanner.Text()
+	return line, legalform.Record{FullName: line}, nil
+}
+
+func (f *textFormat) write(r row, result legalform.Result) error {
+	values := append([]string{r.(string)}, outputValues(f.cfg, result)...)
+	_, err := fmt.Fprintln(f.w, strings.Join(values, "\t"))
+	return err
+}
+
+func (f *textFormat) flush() error {
+	return nil
+}
+
+// csvFormat reads CSV records and appends the output values as additional
+// columns.
+type csvFormat struct {
+	cfg           config
+	r             *csv.Reader
+	w             *csv.Writer
+	initialized   bool
+	column        int
+	countryColumn int
+}
+
+func (f *csvFormat) read() (row, legalform.Record, error) {
+	if !f.initialized {
+		if err := f.init(); err != nil {
+			return nil, legalform.Record{}, err
+		}
+	}
+	record, err := f.r.Read()
+	if err != nil {
+		return nil, legalform.Record{}, err
+	}
+	return record, legalform.Record{
+		FullName: field(record, f.column),
+		Country:  field(record, f.countryColumn),
+	}, nil
+}
+
+// init resolves the columns and writes the header if the input has one. No
+// header is written with -coverage, as the report replaces the rows.
+func (f *csvFormat) init() error {
+	f.initialized = true
+	var header []string
+	if f.cfg.header {
+		var err error
+		if header, err = f.r.Read(); err != nil {
+			return err
+		}
+	}
+	if header != nil && f.cfg.coverage == 0 {
+		if err := f.w.Write(append(slices.Clone(header), outputColumns(f.cfg)...)); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if f.column, err = columnIndex(header, f.cfg.column, 0); err != nil {
+		return err
+	}
+	f.countryColumn, err = columnIndex(header, f.cfg.countryColumn, -1)
+	return err
+}
+
+func (f *csvFormat) write(r row, result legalform.Result) error {
+	return f.w.Write(append(r.([]string), outputValues(f.cfg, result)...))
+}
+
+func (f *csvFormat) flush() error {
+	f.w.Flush()
+	return f.w.Error()
+}
+
+// columnIndex returns the index of the column, which can either be a name from
+// the header or a zero based index. If the column is empty, then the default
+// index is returned.
+func columnIndex(header []string, column string, defaultIndex int) (int, error) {
+	if column == "" {
+		return defaultIndex, nil
+	}
+	if i := slices.Index(header, column); i >= 0 {
+		return i, nil
+	}
+	if i, err := strconv.Atoi(column); err == nil && i >= 0 {
+		return i, nil
+	}
+	return 0, fmt.Errorf("unknown column %q", column)
+}
+
+func field(record []string, i int) string {
+	if i < 0 || i >= len(record) {
+		return ""
+	}
+	return record[i]
+}
+
+// ndjsonFormat reads one JSON object per line and adds the output values as
+// additional fields. The fields of the input keep their order and their values
+// as they were written.
+type ndjsonFormat struct {
+	cfg     config
+	scanner *bufio.Scanner
+	w       io.Writer
+	line    int
+}
+
+func (f *ndjsonFormat) read() (row, legalform.Record, error) {
+	for f.scanner.Scan() {
+		f.line++
+		line := f.scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		object, err := parseObject(line)
+		if err != nil {
+			return nil, legalform.Record{}, fmt.Errorf("line %v: %w", f.line, err)
+		}
+		record, err := f.record(object)
+		if err != nil {
+			return nil, legalform.Record{}, fmt.Errorf("line %v: %w", f.line, err)
+		}
+		return object, record, nil
+	}
+	return nil, legalform.Record{}, eof(f.scanner.Err())
+}
+
+func (f *ndjsonFormat) record(object *object) (legalform.Record, error) {
+	column := f.cfg.column
+	if column == "" {
+		column = "name"
+	}
+	record := legalform.Record{}
+	if err := stringField(object, column, &record.FullName); err != nil {
+		return record, err
+	}
+	if f.cfg.countryColumn != "" {
+		err := stringField(object, f.cfg.countryColumn, &record.Country)
+		return record, err
+	}
+	return record, nil
+}
+
+func (f *ndjsonFormat) write(r row, result legalform.Result) error {
+	object := r.(*object)
+	values := outputValues(f.cfg, result)
+	for i, column := range outputColumns(f.cfg) {
+		value, err := marshalString(values[i])
+		if err != nil {
+			return err
+		}
+		object.set(column, value)
+	}
+	line, err := object.marshal()
+	if err != nil {
+		return err
+	}
+	_, err = f.w.Write(append(line, '\n'))
+	return err
+}
+
+func (f *ndjsonFormat) flush() error {
+	return nil
+}
+
+// marshalString encodes the string as JSON without escaping HTML characters.
+func marshalString(s string) (json.RawMessage, error) {
+	buf := bytes.Buffer{}
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(s); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// object is a JSON object that keeps the order of its fields and their values
+// as they were written.
+type object struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+// parseObject parses the JSON object. If a field appears more than once, then
+// the last value is used at the position of the first one.
+func parseObject(data []byte) (*object, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	t, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if t != json.Delim('{') {
+		return nil, fmt.Errorf("expected a JSON object, got %v", t)
+	}
+
+	o := &object{values: map[string]json.RawMessage{}}
+	for decoder.More() {
+		t, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		o.set(t.(string), value)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unexpected data after the JSON object")
+	}
+	return o, nil
+}
+
+// set sets the value of the field. New fields are added at the end.
+func (o *object) set(key string, value json.RawMessage) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+// marshal encodes the object with its fields in their order.
+func (o *object) marshal() ([]byte, error) {
+	buf := []byte{'{'}
+	for i, key := range o.keys {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		encodedKey, err := marshalString(key)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, encodedKey...)
+		buf = append(buf, ':')
+		buf = append(buf, o.values[key]...)
+	}
+	return append(buf, '}'), nil
+}
+
+// stringField reads the string field into target. Missing fields and null
+// values are treated as empty strings.
+func stringField(object *object, name string, target *string) error {
+	value, ok := object.values[name]
+	if !ok || string(value) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(value, target); err != nil {
+		return fmt.Errorf("field %q: %w", name, err)
+	}
+	return nil
+}
+
+// eof returns io.EOF if the scanner finished without an error.
+func eof(err error) error {
+	if err == nil {
+		return io.EOF
+	}
+	return err
+}
@@ -0,0 +1,201 @@
+// Command legalform strips the legal forms from company names.
+//
+// The names are read from the given file or from stdin, either as plain text
+// with one name per line, as CSV or as NDJSON. The results are written as
+// additional columns or fields, keeping all input data. Large inputs are
+// processed as a stream.
+//
+// Usage:
+//
+//	legalform [flags] [file]
+//
+// Examples:
+//
+//	echo "Example GmbH" | legalform
+//	legalform -format csv -column company -country-column country -alias companies.csv
+//	legalform -format ndjson -column name -middle companies.ndjson
+//
+// With -coverage, no names are written. Instead the most frequent trailing
+// tokens of names without a known legal form are reported per country, which
+// helps to discover missing legal forms:
+//
+//	legalform -format csv -column company -country-column country -coverage 20 companies.csv
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+type config struct {
+	format        string
+	column        string
+	countryColumn string
+	country       string
+	header        bool
+	middle        bool
+	restrict      bool
+	alias         bool
+	workers       int
+	out           string
+	coverage      int
+}
+
+func main() {
+	cfg := config{}
+	flag.StringVar(&cfg.format, "format", "", "input format: text, csv or ndjson (default derived from the file extension or text)")
+	flag.StringVar(&cfg.column, "column", "", "CSV column (name or zero based index) or NDJSON field containing the name (default first column or \"name\")")
+	flag.StringVar(&cfg.countryColumn, "country-column", "", "CSV column or NDJSON field containing the ISO country code")
+	flag.StringVar(&cfg.country, "country", "", "ISO country code to use for all names")
+	flag.BoolVar(&cfg.header, "header", true, "the CSV input starts with a header row")
+	flag.BoolVar(&cfg.middle, "middle", false, "search the legal form anywhere in the name and output the remainder")
+	flag.BoolVar(&cfg.restrict, "restrict", false, "only consider legal forms of the country")
+	flag.BoolVar(&cfg.alias, "alias", false, "output the alias of the legal form")
+	flag.IntVar(&cfg.workers, "workers", runtime.GOMAXPROCS(0), "number of names processed in parallel")
+	flag.StringVar(&cfg.out, "out", "", "output file (default stdout)")
+	flag.IntVar(&cfg.coverage, "coverage", 0, "report the given number of most frequent unmatched suffixes per country instead of the names")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, cfg, flag.Arg(0)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, cfg config, in string) error {
+	r := io.Reader(os.Stdin)
+	if in != "" && in != "-" {
+		f, err := os.Open(in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	w := io.Writer(os.Stdout)
+	if cfg.out != "" {
+		f, err := os.Create(cfg.out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	bw := bufio.NewWriter(w)
+
+	if cfg.format == "" {
+		cfg.format = formatOf(in)
+	}
+	f, err := newFormat(cfg, r, bw)
+	if err != nil {
+		return err
+	}
+	if cfg.coverage > 0 {
+		err = analyze(ctx, cfg, f, bw)
+	} else {
+		err = process(ctx, cfg, f)
+	}
+	if err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// formatOf derives the format from the file extension.
+func formatOf(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return "csv"
+	case ".ndjson", ".jsonl":
+		return "ndjson"
+	}
+	return "text"
+}
+
+// process strips the legal forms of all rows. The rows are kept in the order
+// of the input until their result is available. As the stream only reads a
+// limited number of records ahead, only a limited number of rows is kept in
+// memory.
+func process(ctx context.Context, cfg config, f format) error {
+	rows := &rowQueue{}
+	var readErr error
+	records := func(yield func(legalform.Record) bool) {
+		for {
+			row, record, err := f.read()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					readErr = err
+				}
+				return
+			}
+			if record.Country == "" {
+				record.Country = cfg.country
+			}
+			rows.push(row)
+			if !yield(record) {
+				return
+			}
+		}
+	}
+
+	opts := []legalform.BatchOption{legalform.WithWorkers(cfg.workers)}
+	if cfg.middle {
+		opts = append(opts, legalform.WithStripMiddle())
+	}
+	if cfg.restrict {
+		opts = append(opts, legalform.WithCountryRestriction())
+	}
+
+	for result, err := range legalform.DefaultMatcher().StripStream(ctx, records, opts...) {
+		if err != nil {
+			return err
+		}
+		if err := f.write(rows.pop(), result); err != nil {
+			return err
+		}
+	}
+	// The records were read completely once the stream ends, hence readErr is
+	// safe to access.
+	if readErr != nil {
+		return readErr
+	}
+	return f.flush()
+}
+
+// rowQueue keeps the rows in the order they were read.
+type rowQueue struct {
+	mu   sync.Mutex
+	rows []row
+}
+
+func (q *rowQueue) push(r row) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rows = append(q.rows, r)
+}
+
+func (q *rowQueue) pop() row {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	r := q.rows[0]
+	q.rows[0] = nil
+	q.rows = q.rows[1:]
+	return r
+}
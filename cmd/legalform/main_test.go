@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcess(t *testing.T) {
+	cases := []struct {
+		cfg      config
+		input    string
+		expected string
+	}{
+		{
+			cfg:      config{format: "text", country: "DE", alias: true},
+			input:    "Example GmbH\nNothing\n",
+			expected: "Example GmbH\tExample\tGmbH\tgmbh\nNothing\tNothing\t\t\n",
+		},
+		{
+			cfg:      config{format: "csv", header: true, column: "company", countryColumn: "country", restrict: true},
+			input:    "id,company,country\n1,Example GmbH,DE\n2,\"Foo AG, Bar\",US\n3,Example AG,US\n",
+			expected: "id,company,country,company_name,legal_form\n1,Example GmbH,DE,Example,GmbH\n2,\"Foo AG, Bar\",US,\"Foo AG, Bar\",\n3,Example AG,US,Example AG,\n",
+		},
+		{
+			cfg:      config{format: "csv", column: "1"},
+			input:    "1,Example Inc.\n",
+			expected: "1,Example Inc.,Example,Inc.\n",
+		},
+		{
+			cfg:      config{format: "ndjson", middle: true, alias: true, countryColumn: "country"},
+			input:    "{\"name\":\"Example GmbH & Co. KG Street\",\"country\":\"DE\"}\n\n{\"id\":1}\n",
+			expected: "{\"name\":\"Example GmbH & Co. KG Street\",\"country\":\"DE\",\"company_name\":\"Example\",\"legal_form\":\"GmbH & Co. KG\",\"remainder\":\"Street\",\"legal_form_alias\":\"gmbhcokg\"}\n{\"id\":1,\"company_name\":\"\",\"legal_form\":\"\",\"remainder\":\"\",\"legal_form_alias\":\"\"}\n",
+		},
+		{
+			cfg:      config{format: "ndjson"},
+			input:    "{\"z\":1.50, \"name\": \"Example Ltd\", \"big\":12345678901234567890,\"nested\":{\"b\": [1e3, true]},\"legal_form\":\"old\"}\n",
+			expected: "{\"z\":1.50,\"name\":\"Example Ltd\",\"big\":12345678901234567890,\"nested\":{\"b\": [1e3, true]},\"legal_form\":\"Ltd\",\"company_name\":\"Example\"}\n",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			c.cfg.workers = 2
+			out := bytes.Buffer{}
+			f, err := newFormat(c.cfg, strings.NewReader(c.input), &out)
+			assert.NoError(t, err)
+			assert.NoError(t, process(context.Background(), c.cfg, f))
+			assert.Equal(t, c.expected, out.String())
+		})
+	}
+}
+
+func TestProcessPreservesOrder(t *testing.T) {
+	input := strings.Builder{}
+	expected := strings.Builder{}
+	for i := range 5000 {
+		fmt.Fprintf(&input, "Example %v Ltd\n", i)
+		fmt.Fprintf(&expected, "Example %v Ltd\tExample %v\tLtd\n", i, i)
+	}
+
+	cfg := config{format: "text", workers: 8}
+	out := bytes.Buffer{}
+	f, err := newFormat(cfg, strings.NewReader(input.String()), &out)
+	assert.NoError(t, err)
+	assert.NoError(t, process(context.Background(), cfg, f))
+	assert.Equal(t, expected.String(), out.String())
+}
+
+func TestProcessErrors(t *testing.T) {
+	cases := []struct {
+		cfg   config
+		input string
+	}{
+		{
+			cfg:   config{format: "ndjson"},
+			input: "{\"name\":\"Example GmbH\"}\n{bad\n",
+		},
+		{
+			cfg:   config{format: "ndjson"},
+			input: "{\"name\":1}\n",
+		},
+		{
+			cfg:   config{format: "ndjson"},
+			input: "[\"Example GmbH\"]\n",
+		},
+		{
+			cfg:   config{format: "ndjson"},
+			input: "{\"name\":\"Example GmbH\"} {}\n",
+		},
+		{
+			cfg:   config{format: "csv", header: true, column: "company"},
+			input: "id,name\n1,Example GmbH\n",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			c.cfg.workers = 1
+			f, err := newFormat(c.cfg, strings.NewReader(c.input), &bytes.Buffer{})
+			assert.NoError(t, err)
+			assert.Error(t, process(context.Background(), c.cfg, f))
+		})
+	}
+
+	_, err := newFormat(config{format: "xml"}, strings.NewReader(""), &bytes.Buffer{})
+	assert.Error(t, err)
+}
+
+func TestAnalyze(t *testing.T) {
+	cfg := config{format: "csv", header: true, column: "company", countryColumn: "country", coverage: 2}
+	input := "company,country\nExample GmbH,DE\nFoo Widget Works,DE\nBar Widget-Works,DE\nBaz Works,de\nQux Works,\n"
+	expected := "-\t1 names\t0 matched\n" +
+		"-\t1\tworks\tWorks\n" +
+		"DE\t4 names\t1 matched\n" +
+		"DE\t2\tworks\tWorks\n" +
+		"DE\t2\twidgetworks\tWidget Works | Widget-Works\n"
+
+	out := bytes.Buffer{}
+	f, err := newFormat(cfg, strings.NewReader(input), &out)
+	assert.NoError(t, err)
+	assert.NoError(t, analyze(context.Background(), cfg, f, &out))
+	assert.NoError(t, f.flush())
+	assert.Equal(t, expected, out.String())
+}
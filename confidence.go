@@ -0,0 +1,76 @@
+package legalform
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// StripRecord processes a single record like StripBatch and returns its result
+// including the confidence.
+func (m *Matcher) StripRecord(record Record, opts ...BatchOption) Result {
+	return m.process(newBatchConfig(opts), 0, record)
+}
+
+// confidence rates how certain the stripped legal form really is a legal form.
+//
+// It is the product of the following factors, each between 0 and 1:
+//
+//   - specificity: longer legal forms are more specific, e.g. "GmbH & Co. KG"
+//     rates higher than "AG",
+//   - ambiguity: legal forms that are also ordinary words rate lower, see
+//     AmbiguityOf,
+//   - appearance: legal forms written in upper case or with punctuation rate
+//     higher than those written in lower case,
+//   - country: legal forms that are used in the country of the company rate
+//     higher than those that are only used in other countries,
+//   - name: the shorter the remaining name, the more likely the legal form was
+//     part of the name, e.g. "A AG".
+//
+// Legal forms in the middle of the name rate lower, as the remainder might
+// have been part of the name as well.
+func (m *Matcher) confidence(result Result) float64 {
+	if result.LegalForm == "" {
+		return 0
+	}
+	key := cleanKey(result.LegalForm)
+	country := elfAliasCountry(result.Country)
+
+	specificity := 0.7 + 0.3*min(1, float64(utf8.RuneCountInString(key))/4)
+
+	validity := 0.9
+	if _, assigned := m.countries.keys[key]; assigned && country != "" && m.countries.knows(country) {
+		validity = 0.5
+		if m.countries.assigned(country, key) {
+			validity = 1
+		}
+	}
+
+	ambiguity := ambiguityFactor(ambiguity(key))
+	if validity == 1 {
+		ambiguity = max(ambiguity, 0.8)
+	}
+
+	appearance := 0.75
+	if first, _ := utf8.DecodeRuneInString(result.LegalForm); hasLegalFormEvidence(result.LegalForm, result.FullName) {
+		appearance = 1
+	} else if !unicode.IsLower(first) {
+		appearance = 0.9
+	}
+
+	name := min(1, 0.5+float64(utf8.RuneCountInString(cleanKey(result.Name)))/8)
+	if result.Remainder != "" {
+		name *= 0.8
+	}
+
+	return specificity * ambiguity * appearance * validity * name
+}
+
+func ambiguityFactor(a Ambiguity) float64 {
+	switch a {
+	case AmbiguityHigh:
+		return 0.5
+	case AmbiguityMedium:
+		return 0.85
+	}
+	return 1
+}
@@ -0,0 +1,38 @@
+package legalform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestConfidence(t *testing.T) {
+	cases := []struct {
+		record   legalform.Record
+		opts     []legalform.BatchOption
+		expected float64
+	}{
+		{record: legalform.Record{FullName: "Example"}, expected: 0},
+		{record: legalform.Record{FullName: "Example GmbH & Co. KG", Country: "DE"}, expected: 1},
+		{record: legalform.Record{FullName: "Example GmbH", Country: "DE"}, expected: 0.9},
+		{record: legalform.Record{FullName: "Example GmbH"}, expected: 0.81},
+		{record: legalform.Record{FullName: "Example gmbh"}, expected: 0.675},
+		{record: legalform.Record{FullName: "Example GmbH", Country: "US"}, expected: 0.45},
+		{record: legalform.Record{FullName: "Example Ltd", Country: "GB"}, expected: 0.8325},
+		{record: legalform.Record{FullName: "Example AG", Country: "DE"}, expected: 0.7225},
+		{record: legalform.Record{FullName: "Example AG"}, expected: 0.65025},
+		{record: legalform.Record{FullName: "Example AS"}, expected: 0.3825},
+		{record: legalform.Record{FullName: "Example AS", Country: "NO"}, expected: 0.68},
+		{record: legalform.Record{FullName: "AB GmbH", Country: "DE"}, expected: 0.675},
+		{record: legalform.Record{FullName: "Example GmbH Street", Country: "DE"}, opts: []legalform.BatchOption{legalform.WithStripMiddle()}, expected: 0.72},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			result := legalform.DefaultMatcher().StripRecord(c.record, c.opts...)
+			assert.InDelta(t, c.expected, result.Confidence, 0.0001)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package legalform
+
+import (
+	"maps"
+	"slices"
+)
+
+// countryLegalForms lists the legal forms of Default that are only used in a
+// specific country, but are not already covered by DefaultAliases.
+var countryLegalForms = map[string][]string{
+	"RU": {
+		"обществосограниченноиответственностью", "публичноеакционерноеобщество",
+		"закрытоеакционерноеобщество", "открытоеакционерноеобщество",
+		"государственноеунитарноепредприятие", "муниципальноеунитарноепредприятие",
+		"акционерноеобщество", "полноетоварищество", "товариществонавере",
+		"ооо", "пао", "oao", "оао", "зао", "ао", "пт", "гуп", "муп", "тв", "ojsc",
+	},
+	"VN": {
+		"congtytrachnhiemhuuhan", "congtytnhh", "congtnhh", "cttnhh", "ctytnhh",
+		"congtycophan", "congtycp", "ctycp", "ctcp", "tongcongty",
+		"doanhnghieptunhan", "dntn", "congtyhopdanh", "congtyhd",
+		"congtyliendoanh", "congtyld", "congtynhanuoc", "congtynn",
+	},
+	"JP": {
+		"株式会社", "株", "有限会社", "有", "合同会社", "合", "合名会社", "名", "合資会社", "資",
+		"外国会社等", "外", "その他", "そ", "医療法人", "医", "その他の設立登記法人",
+		"地方公共団体", "国の機関",
+		"kabushikikaisha", "kk", "godokaisha", "gk", "yugenkaisha", "yk",
+		"goshikaisha", "gomeikaisha",
+		"zhushihuishe", "hetonghuishe", "youxianhuishe", "hezihuishe", "heminghuishe",
+		"corpinc", "corpkk", "corpyk",
+	},
+	"KR": {
+		"주식회사", "㈜", "유한회사", "유", "유한책임회사", "합자회사", "합명회사",
+	},
+	"CN": {
+		"有限公司", "有限责任公司", "有限責任公司", "股份有限公司", "股份公司",
+	},
+	"AE": {
+		"fze", "fzc", "prjsc",
+	},
+}
+
+// prefixCountries are the countries in which the legal form is commonly
+// written in front of the name, e.g. "ООО Ромашка" or "PT Example Indonesia".
+var prefixCountries = []string{"BY", "ID", "JP", "KR", "KZ", "RO", "RU", "UA", "VN"}
+
+// countryIndex maps each cleaned legal form to the countries in which it is
+// used. Legal forms that are used in every country are assigned to "*".
+type countryIndex struct {
+	keys      map[string]map[string]struct{}
+	countries map[string]struct{}
+}
+
+// defaultCountries is the country index of the default legal forms.
+var defaultCountries = newCountryIndex(DefaultAliases, countryLegalForms, DefaultRegistry)
+
+func newCountryIndex(aliases Aliases, legalForms map[string][]string, registry Registry) countryIndex {
+	idx := countryIndex{
+		keys:      map[string]map[string]struct{}{},
+		countries: map[string]struct{}{},
+	}
+	for country, countryAliases := range aliases {
+		for legalForm, alias := range countryAliases {
+			idx.add(country, legalForm)
+			idx.add(country, alias)
+		}
+	}
+	for country, keys := range legalForms {
+		for _, key := range keys {
+			idx.add(country, key)
+		}
+	}
+	for key, forms := range registry {
+		for _, form := range forms {
+			for _, country := range form.Countries {
+				idx.add(elfAliasCountry(country), key)
+			}
+		}
+	}
+	return idx
+}
+
+func (c countryIndex) add(country, key string) {
+	if c.keys[key] == nil {
+		c.keys[key] = map[string]struct{}{}
+	}
+	c.keys[key][country] = struct{}{}
+	c.countries[country] = struct{}{}
+}
+
+// assigned checks if the legal form is used in the country or in every
+// country. Unlike allows, legal forms that are not assigned to any country are
+// not accepted.
+func (c countryIndex) assigned(country, key string) bool {
+	countries := c.keys[key]
+	_, inCountry := countries[country]
+	_, global := countries["*"]
+	return inCountry || global
+}
+
+// knows checks if any legal form is assigned to the country.
+func (c countryIndex) knows(country string) bool {
+	_, ok := c.countries[country]
+	return ok
+}
+
+// allows checks if the legal form is used in the country. Legal forms that are
+// used in every country are allowed unless they have a high ambiguity, e.g.
+// "Co". Legal forms that are not assigned to any country are only allowed if
+// they have a low ambiguity, i.e. not "A" or "BR".
+func (c countryIndex) allows(country, key string) bool {
+	countries, assigned := c.keys[key]
+	if _, inCountry := countries[country]; inCountry {
+		return true
+	}
+	if _, global := countries["*"]; global {
+		return ambiguity(key) < AmbiguityHigh
+	}
+	return !assigned && ambiguity(key) == AmbiguityLow
+}
+
+// specific checks if the legal form is assigned to the country itself and not
+// only used in every country.
+func (c countryIndex) specific(country, key string) bool {
+	_, ok := c.keys[key][country]
+	return ok
+}
+
+// leadsName checks if the legal form is written in front of the name, i.e. it
+// is used in one of the prefixCountries. Legal forms that are not assigned to
+// any country are only accepted if they have a low ambiguity.
+func (c countryIndex) leadsName(key string) bool {
+	countries, assigned := c.keys[key]
+	if !assigned {
+		return ambiguity(key) == AmbiguityLow
+	}
+	return slices.ContainsFunc(prefixCountries, func(country string) bool {
+		_, ok := countries[country]
+		return ok
+	})
+}
+
+// countryForms restricts the legal forms to those that are allowed in a
+// specific country.
+type countryForms struct {
+	forms     LegalForms
+	countries countryIndex
+	country   string
+}
+
+func (c countryForms) has(key string) bool {
+	return c.forms.has(key) && c.countries.allows(c.country, key)
+}
+
+func (c countryForms) suffixes(cleanTokens []string, starts []int) []int {
+	return lookupSuffixes(c, cleanTokens, starts)
+}
+
+func (c countryForms) prefixes(cleanTokens []string, ends []int) []int {
+	return lookupPrefixes(c, cleanTokens, ends)
+}
+
+// StripForCountry strips the legal form from the end of the full company name
+// like Strip, but only considers legal forms that are valid for the provided
+// ISO country code.
+//
+// Legal forms that are only known to be used in other countries, e.g. "AG"
+// for a company from the US, will not be stripped. Legal forms that are used
+// in every country or that are not assigned to any country are only considered
+// if they are not ambiguous, e.g. "Foo Co" or "Foo A" are kept for a company
+// from the US. If several legal forms match, then the longest allowed one is
+// stripped, e.g. "GmbH & Co. KGaA" and not only "KGaA" for a company from
+// Germany. If the country is unknown, then this behaves exactly like Strip.
+// Both "GB" and "UK" refer to the United Kingdom.
+func (f LegalForms) StripForCountry(country, fullName string) (string, string) {
+	return strip(f.forCountry(country), fullName)
+}
+
+// forCountry returns the index for the legal forms of the country.
+func (f LegalForms) forCountry(country string) index {
+	country = elfAliasCountry(country)
+	if !defaultCountries.knows(country) {
+		return f
+	}
+	return countryForms{forms: f, countries: defaultCountries, country: country}
+}
+
+// ForCountries returns a new instance containing only the legal forms that are
+// used in at least one of the provided ISO country codes or in every country.
+//
+// Which legal form is used in which country is derived from DefaultAliases
+// and DefaultRegistry. Legal forms that are not assigned to any country are
+// only included if they have a low ambiguity, like in StripForCountry, e.g.
+// "GmbH & Co. KGaA", but not "BR". Both "GB" and "UK" refer to the United
+// Kingdom.
+//
+// This allows to limit the recognition to the countries of the data, e.g.
+// Default.ForCountries("DE", "AT", "CH") no longer strips "AS" from names.
+func (f LegalForms) ForCountries(countries ...string) LegalForms {
+	normalized := make([]string, len(countries))
+	for i, country := range countries {
+		normalized[i] = elfAliasCountry(country)
+	}
+	return f.filter(func(key string) bool {
+		if _, assigned := defaultCountries.keys[key]; !assigned {
+			return len(normalized) > 0 && ambiguity(key) == AmbiguityLow
+		}
+		return slices.ContainsFunc(normalized, func(country string) bool {
+			return defaultCountries.assigned(country, key)
+		})
+	})
+}
+
+// Countries returns the sorted ISO country codes in which the legal form is
+// used according to DefaultAliases and DefaultRegistry. Legal forms that are
+// used in every country contain "*".
+//
+// The legal form can either be written as in a company name, e.g. "GmbH", or
+// as a key. If the legal form is not assigned to any country, then nil is
+// returned.
+func (f LegalForms) Countries(legalForm string) []string {
+	key := cleanKey(legalForm)
+	if !f.has(key) {
+		return nil
+	}
+	countries := defaultCountries.keys[key]
+	if len(countries) == 0 {
+		return nil
+	}
+	return slices.Sorted(maps.Keys(countries))
+}
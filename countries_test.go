@@ -0,0 +1,175 @@
+package legalform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestStripForCountry(t *testing.T) {
+	cases := []struct {
+		country             string
+		input               string
+		expectedCompanyName string
+		expectedLegalForm   string
+	}{
+		{
+			country:             "DE",
+			input:               "Example AG",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "AG",
+		},
+		{
+			country:             "US",
+			input:               "Example AG",
+			expectedCompanyName: "Example AG",
+			expectedLegalForm:   "",
+		},
+		{
+			country:             "us",
+			input:               "Foo Bar AS",
+			expectedCompanyName: "Foo Bar AS",
+			expectedLegalForm:   "",
+		},
+		{
+			country:             "NO",
+			input:               "Foo Bar AS",
+			expectedCompanyName: "Foo Bar",
+			expectedLegalForm:   "AS",
+		},
+		{
+			country:             "US",
+			input:               "Example Holdings Inc.",
+			expectedCompanyName: "Example Holdings",
+			expectedLegalForm:   "Inc.",
+		},
+		{
+			country:             "DE",
+			input:               "Example Limited",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "Limited",
+		},
+		{
+			country:             "US",
+			input:               "Example GmbH LLC",
+			expectedCompanyName: "Example GmbH",
+			expectedLegalForm:   "LLC",
+		},
+		{
+			country:             "DE",
+			input:               "Example GmbH & Co. KG",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "GmbH & Co. KG",
+		},
+		{
+			country:             "DE",
+			input:               "Example GmbH & Co. KGaA",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "GmbH & Co. KGaA",
+		},
+		{
+			country:             "DE",
+			input:               "Example AG & Co. OHG",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "AG & Co. OHG",
+		},
+		{
+			country:             "GB",
+			input:               "Example AG",
+			expectedCompanyName: "Example AG",
+			expectedLegalForm:   "",
+		},
+		{
+			country:             "UK",
+			input:               "Example AG",
+			expectedCompanyName: "Example AG",
+			expectedLegalForm:   "",
+		},
+		{
+			country:             "gb",
+			input:               "Example Ltd",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "Ltd",
+		},
+		{
+			country:             "JP",
+			input:               "トヨタ株式会社",
+			expectedCompanyName: "トヨタ",
+			expectedLegalForm:   "株式会社",
+		},
+		{
+			country:             "CN",
+			input:               "トヨタ株式会社",
+			expectedCompanyName: "トヨタ株式会社",
+			expectedLegalForm:   "",
+		},
+		{
+			country:             "US",
+			input:               "Foo A",
+			expectedCompanyName: "Foo A",
+			expectedLegalForm:   "",
+		},
+		{
+			country:             "US",
+			input:               "Foo Bo",
+			expectedCompanyName: "Foo Bo",
+			expectedLegalForm:   "",
+		},
+		{
+			country:             "US",
+			input:               "Foo BR",
+			expectedCompanyName: "Foo BR",
+			expectedLegalForm:   "",
+		},
+		{
+			country:             "US",
+			input:               "Foo Co",
+			expectedCompanyName: "Foo Co",
+			expectedLegalForm:   "",
+		},
+		{
+			country:             "US",
+			input:               "Example Co Ltd",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "Co Ltd",
+		},
+		{
+			country:             "JP",
+			input:               "Example Co Ltd",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "Co Ltd",
+		},
+		{
+			country:             "XX",
+			input:               "Foo A",
+			expectedCompanyName: "Foo",
+			expectedLegalForm:   "A",
+		},
+		{
+			country:             "XX",
+			input:               "Foo Bar AS",
+			expectedCompanyName: "Foo Bar",
+			expectedLegalForm:   "AS",
+		},
+		{
+			country:             "",
+			input:               "",
+			expectedCompanyName: "",
+			expectedLegalForm:   "",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			actualCompany, actualLegalForm := legalform.Default.StripForCountry(c.country, c.input)
+			assert.Equal(t, c.expectedCompanyName, actualCompany)
+			assert.Equal(t, c.expectedLegalForm, actualLegalForm)
+
+			actualCompany, actualLegalForm = legalform.DefaultMatcher().StripForCountry(c.country, c.input)
+			assert.Equal(t, c.expectedCompanyName, actualCompany)
+			assert.Equal(t, c.expectedLegalForm, actualLegalForm)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package legalform
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// maxSuffixTokens is the maximum number of trailing tokens that are collected
+// for names without a legal form.
+const maxSuffixTokens = 4
+
+// maxSuffixExamples is the maximum number of different spellings that are kept
+// for each unmatched suffix.
+const maxSuffixExamples = 3
+
+// MaxUnmatchedSuffixes is the maximum number of different unmatched suffixes a
+// Coverage keeps per country. Once it is reached, the least frequent suffixes
+// are removed until at most half of them are left.
+const MaxUnmatchedSuffixes = 100_000
+
+// Coverage analyzes a corpus of company names to discover legal forms that are
+// missing.
+//
+// For every name without a legal form at its end, the trailing 1 to 4 tokens
+// are collected. Suffixes that only differ in their spelling, e.g. "Pty. Ltd."
+// and "PTY LTD", are counted together, because they share the same cleaned
+// key. Frequent unmatched suffixes are good candidates for extending the legal
+// forms.
+//
+// To limit the memory of large corpora, at most MaxUnmatchedSuffixes different
+// suffixes are kept per country. Rare suffixes are removed when the limit is
+// reached, hence their counts start again at zero if they occur later on.
+// Frequent suffixes are not affected.
+//
+// A Coverage is not safe for concurrent use.
+type Coverage struct {
+	idx       index
+	countries map[string]*countryCoverage
+}
+
+type countryCoverage struct {
+	names    int
+	matched  int
+	suffixes map[string]*UnmatchedSuffix
+}
+
+// CoverageReport summarizes the analyzed company names per country.
+type CoverageReport []CountryCoverage
+
+// CountryCoverage summarizes the analyzed company names of a single country.
+type CountryCoverage struct {
+	// Country is the ISO country code as it was provided to Add in upper case.
+	// It is empty for names without a country.
+	Country string
+	// Names is the number of analyzed company names.
+	Names int
+	// Matched is the number of company names for which a legal form was found.
+	Matched int
+	// Suffixes are the most frequent unmatched suffixes, starting with the most
+	// frequent one.
+	Suffixes []UnmatchedSuffix
+}
+
+// UnmatchedSuffix is a sequence of trailing tokens of company names without a
+// legal form.
+type UnmatchedSuffix struct {
+	// Key is the cleaned suffix as it would be used in LegalForms.
+	Key string
+	// Tokens is the number of tokens of the suffix.
+	Tokens int
+	// Count is the number of company names ending with the suffix.
+	Count int
+	// Examples are the first different spellings of the suffix.
+	Examples []string
+}
+
+// NewCoverage creates a new Coverage for the matcher.
+func NewCoverage(m *Matcher) *Coverage {
+	return &Coverage{
+		idx:       m,
+		countries: map[string]*countryCoverage{},
+	}
+}
+
+// Add strips the legal form from the company name and collects its trailing
+// tokens if no legal form was found.
+func (c *Coverage) Add(country, fullName string) {
+	country = strings.ToUpper(country)
+	cc, ok := c.countries[country]
+	if !ok {
+		cc = &countryCoverage{suffixes: map[string]*UnmatchedSuffix{}}
+		c.countries[country] = cc
+	}
+	cc.names++
+
+	tokens := tokenize(c.idx, fullName)
+	cleanTokens := cleanTokens(tokens)
+	if suffixStart(c.idx, cleanTokens, nil) < len(cleanTokens) {
+		cc.matched++
+		return
+	}
+
+	// The first token is never considered to be part of the legal form.
+	for n := 1; n <= maxSuffixTokens && n < len(tokens); n++ {
+		start := len(tokens) - n
+		key := strings.Join(cleanTokens[start:], "")
+		if !strings.ContainsFunc(key, unicode.IsLetter) {
+			continue
+		}
+		cc.add(key, n, join(tokens[start:], fullName))
+	}
+}
+
+func (cc *countryCoverage) add(key string, tokens int, example string) {
+	suffix, ok := cc.suffixes[key]
+	if !ok {
+		if len(cc.suffixes) >= MaxUnmatchedSuffixes {
+			cc.prune()
+		}
+		suffix = &UnmatchedSuffix{Key: key, Tokens: tokens}
+		cc.suffixes[key] = suffix
+	}
+	suffix.Count++
+	if len(suffix.Examples) < maxSuffixExamples && !slices.Contains(suffix.Examples, example) {
+		suffix.Examples = append(suffix.Examples, example)
+	}
+}
+
+// prune removes the least frequent suffixes until at most half of
+// MaxUnmatchedSuffixes are left.
+func (cc *countryCoverage) prune() {
+	for count := 1; len(cc.suffixes) > MaxUnmatchedSuffixes/2; count++ {
+		maps.DeleteFunc(cc.suffixes, func(_ string, suffix *UnmatchedSuffix) bool {
+			return suffix.Count <= count
+		})
+	}
+}
+
+// Report returns the coverage of all countries sorted by their country code.
+//
+// For each country at most limit unmatched suffixes are returned. If limit is
+// 0 or less, then all unmatched suffixes are returned.
+func (c *Coverage) Report(limit int) CoverageReport {
+	report := make(CoverageReport, 0, len(c.countries))
+	for _, country := range slices.Sorted(maps.Keys(c.countries)) {
+		cc := c.countries[country]
+		suffixes := make([]UnmatchedSuffix, 0, len(cc.suffixes))
+		for _, suffix := range cc.suffixes {
+			s := *suffix
+			s.Examples = slices.Clone(suffix.Examples)
+			suffixes = append(suffixes, s)
+		}
+		slices.SortFunc(suffixes, func(a, b UnmatchedSuffix) int {
+			return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Tokens, b.Tokens), cmp.Compare(a.Key, b.Key))
+		})
+		if limit > 0 && len(suffixes) > limit {
+			suffixes = suffixes[:limit]
+		}
+		report = append(report, CountryCoverage{
+			Country:  country,
+			Names:    cc.names,
+			Matched:  cc.matched,
+			Suffixes: slices.Clip(suffixes),
+		})
+	}
+	return report
+}
@@ -0,0 +1,70 @@
+package legalform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestCoverage(t *testing.T) {
+	m := legalform.NewMatcher(legalform.LegalForms{"gmbh": {}, "ltd": {}})
+	c := legalform.NewCoverage(m)
+	c.Add("de", "Example GmbH")
+	c.Add("DE", "Foo Bar KG")
+	c.Add("DE", "Bar K.G.")
+	c.Add("DE", "Baz 2")
+	c.Add("DE", "Single")
+	c.Add("AU", "Example Pty. Ltd.")
+	c.Add("AU", "Example PTY")
+	c.Add("", "Example Inc.")
+
+	expected := legalform.CoverageReport{
+		{
+			Country: "",
+			Names:   1,
+			Suffixes: []legalform.UnmatchedSuffix{
+				{Key: "inc", Tokens: 1, Count: 1, Examples: []string{"Inc."}},
+			},
+		},
+		{
+			Country: "AU",
+			Names:   2,
+			Matched: 1,
+			Suffixes: []legalform.UnmatchedSuffix{
+				{Key: "pty", Tokens: 1, Count: 1, Examples: []string{"PTY"}},
+			},
+		},
+		{
+			Country: "DE",
+			Names:   5,
+			Matched: 1,
+			Suffixes: []legalform.UnmatchedSuffix{
+				{Key: "kg", Tokens: 1, Count: 2, Examples: []string{"KG", "K.G."}},
+				{Key: "barkg", Tokens: 2, Count: 1, Examples: []string{"Bar KG"}},
+			},
+		},
+	}
+	assert.Equal(t, expected, c.Report(0))
+
+	limited := c.Report(1)
+	assert.Len(t, limited[2].Suffixes, 1)
+	assert.Equal(t, "kg", limited[2].Suffixes[0].Key)
+}
+
+func TestCoverageLimitsSuffixes(t *testing.T) {
+	c := legalform.NewCoverage(legalform.NewMatcher(legalform.LegalForms{"gmbh": {}}))
+	for i := range legalform.MaxUnmatchedSuffixes + 10 {
+		c.Add("DE", "Example KG")
+		c.Add("DE", fmt.Sprintf("Example X%v", i))
+	}
+
+	report := c.Report(0)
+	assert.Len(t, report, 1)
+	assert.Equal(t, 2*(legalform.MaxUnmatchedSuffixes+10), report[0].Names)
+	assert.LessOrEqual(t, len(report[0].Suffixes), legalform.MaxUnmatchedSuffixes)
+	assert.Equal(t, legalform.UnmatchedSuffix{
+		Key: "kg", Tokens: 1, Count: legalform.MaxUnmatchedSuffixes + 10, Examples: []string{"KG"},
+	}, report[0].Suffixes[0])
+}
@@ -0,0 +1,171 @@
+package legalform
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Relation describes how the other name of a Designation relates to the legal
+// entity.
+type Relation int
+
+// Possible relations between the legal entity and the other name.
+const (
+	// RelationNone is used if the name does not contain a connector.
+	RelationNone Relation = iota
+	// RelationTradingAs names are used by the legal entity for its business,
+	// e.g. "t/a", "d/b/a" or "h.o.d.n.".
+	RelationTradingAs
+	// RelationFormerly names were used by the legal entity before, e.g.
+	// "f/k/a", "vormals" or "anciennement".
+	RelationFormerly
+)
+
+var relationNames = map[Relation]string{
+	RelationNone:      "none",
+	RelationTradingAs: "trading as",
+	RelationFormerly:  "formerly",
+}
+
+// String returns the English name of the relation.
+func (r Relation) String() string {
+	if name, ok := relationNames[r]; ok {
+		return name
+	}
+	return relationNames[RelationNone]
+}
+
+// connectors are the words that connect the legal entity with another name for
+// each relation.
+var connectors = map[Relation][]string{
+	RelationTradingAs: {
+		"t/a", "trading as", "d/b/a", "dba", "doing business as",
+		"h.o.d.n.", "handelend onder de naam",
+		"sous l'enseigne", "sous le nom commercial",
+	},
+	RelationFormerly: {
+		"f/k/a", "f.k.a.", "fka", "formerly", "formerly known as", "previously",
+		"vormals", "vorm.", "ehemals", "früher",
+		"anciennement",
+		"voorheen", "v/h",
+		"anteriormente",
+		"già", "precedentemente",
+		"tidigare", "tidligere",
+	},
+}
+
+// relations is the index of all connectors.
+var relations = newRelationIndex(connectors)
+
+// relationIndex maps the keys of connectors to their relation.
+type relationIndex struct {
+	keys      map[string]Relation
+	maxTokens int
+}
+
+func newRelationIndex(connectors map[Relation][]string) relationIndex {
+	idx := relationIndex{keys: map[string]Relation{}}
+	for relation, texts := range connectors {
+		for _, text := range texts {
+			idx.keys[cleanKey(text)] = relation
+			idx.maxTokens = max(idx.maxTokens, len(strings.Fields(text)))
+		}
+	}
+	return idx
+}
+
+// Designation is a full company name that was split into the name of the legal
+// entity and another name, e.g. a trading name or a previous name.
+type Designation struct {
+	// Name is the plain name of the legal entity.
+	Name string
+	// LegalForm is the legal form of the legal entity.
+	LegalForm string
+	// Relation describes how the other name relates to the legal entity.
+	Relation Relation
+	// Connector is the connector as written, e.g. "t/a" or "vormals".
+	Connector string
+	// OtherName is the plain trading or previous name.
+	OtherName string
+	// OtherLegalForm is the legal form of the other name, if any.
+	OtherLegalForm string
+}
+
+// ParseDesignation splits the full company name at the first connector like
+// "t/a", "d/b/a", "f/k/a" or "vormals" and strips the legal form from both
+// parts like Strip, e.g. "Foo GmbH vormals Bar GmbH" results in "Foo" and
+// "GmbH" for the legal entity and "Bar" and "GmbH" for its previous name.
+//
+// Brackets around the other name are removed, e.g. "X Corp (f/k/a Y Corp)".
+// Connectors with up to two letters are only recognized if they are written
+// with punctuation, e.g. "t/a", but not "TA". Other connectors without
+// punctuation, e.g. "formerly" or "dba", are only recognized directly after a
+// legal form, e.g. in "Foo GmbH vormals Bar GmbH", but not in
+// "Oracle DBA Services Ltd". If there is no connector, then
+// the name and the legal form are the result of Strip and the relation is
+// RelationNone.
+func (f LegalForms) ParseDesignation(fullName string) Designation {
+	return parseDesignation(f, fullName, func(name string) (string, string) {
+		return strip(f, name)
+	})
+}
+
+// ParseDesignation splits the full company name at the first connector and
+// strips the legal form from both parts like LegalForms.ParseDesignation.
+func (m *Matcher) ParseDesignation(fullName string) Designation {
+	return parseDesignation(m, fullName, func(name string) (string, string) {
+		return m.strip(m, "", name)
+	})
+}
+
+func parseDesignation(idx index, fullName string, strip func(string) (string, string)) Designation {
+	b := getTokens(idx, fullName)
+	defer b.release()
+	start, end, relation := findConnector(idx, b.tokens, b.cleanTokens, fullName, b.starts)
+	if relation == RelationNone {
+		name, legalForm := strip(fullName)
+		return Designation{Name: name, LegalForm: legalForm}
+	}
+
+	d := Designation{
+		Relation:  relation,
+		Connector: TrimName(join(b.tokens[start:end], fullName)),
+	}
+	d.Name, d.LegalForm = strip(TrimName(join(b.tokens[:start], fullName)))
+	d.OtherName, d.OtherLegalForm = strip(TrimName(join(b.tokens[end:], fullName)))
+	return d
+}
+
+// findConnector returns the token range of the first and longest connector as
+// well as its relation. Connectors are never the first or the last token.
+//
+// Connectors that are written without punctuation, e.g. "formerly" or "dba",
+// are only recognized directly after a legal form like in StripMiddle, as they
+// might be part of the name otherwise. The buf is used to collect the
+// candidates and may be nil.
+//
+// If no connector was found, then RelationNone is returned.
+func findConnector(idx index, tokens []token, cleanTokens []string, fullName string, buf []int) (int, int, Relation) {
+	for start := 1; start < len(tokens)-1; start++ {
+		for end := min(len(tokens)-1, start+relations.maxTokens); end > start; end-- {
+			key := strings.Join(cleanTokens[start:end], "")
+			relation, ok := relations.keys[key]
+			if !ok {
+				continue
+			}
+			punctuated := strings.ContainsAny(join(tokens[start:end], fullName), "./")
+			if !punctuated && (utf8.RuneCountInString(key) <= 2 || !endsWithLegalForm(idx, tokens[:start], cleanTokens[:start], buf)) {
+				continue
+			}
+			return start, end, relation
+		}
+	}
+	return len(tokens), len(tokens), RelationNone
+}
+
+// endsWithLegalForm checks if the legal form that findMiddle finds in the
+// tokens ends with the last token.
+func endsWithLegalForm(idx index, tokens []token, cleanTokens []string, buf []int) bool {
+	start, end := findMiddle(idx, tokens, cleanTokens, buf)
+	return start < end && end == len(tokens)
+}
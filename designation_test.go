@@ -0,0 +1,91 @@
+package legalform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestParseDesignation(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected legalform.Designation
+	}{
+		{
+			"Smith Holdings Ltd t/a Smith's Bakery",
+			legalform.Designation{Name: "Smith Holdings", LegalForm: "Ltd", Relation: legalform.RelationTradingAs, Connector: "t/a", OtherName: "Smith's Bakery"},
+		},
+		{
+			"Acme Inc. d/b/a Widgets",
+			legalform.Designation{Name: "Acme", LegalForm: "Inc.", Relation: legalform.RelationTradingAs, Connector: "d/b/a", OtherName: "Widgets"},
+		},
+		{
+			"Acme Inc DBA Widgets LLC",
+			legalform.Designation{Name: "Acme", LegalForm: "Inc", Relation: legalform.RelationTradingAs, Connector: "DBA", OtherName: "Widgets", OtherLegalForm: "LLC"},
+		},
+		{
+			"Example Ltd trading as Example Shop",
+			legalform.Designation{Name: "Example", LegalForm: "Ltd", Relation: legalform.RelationTradingAs, Connector: "trading as", OtherName: "Example Shop"},
+		},
+		{
+			"Example B.V. h.o.d.n. Voorbeeld",
+			legalform.Designation{Name: "Example", LegalForm: "B.V.", Relation: legalform.RelationTradingAs, Connector: "h.o.d.n.", OtherName: "Voorbeeld"},
+		},
+		{
+			"Foo GmbH vormals Bar GmbH",
+			legalform.Designation{Name: "Foo", LegalForm: "GmbH", Relation: legalform.RelationFormerly, Connector: "vormals", OtherName: "Bar", OtherLegalForm: "GmbH"},
+		},
+		{
+			"X Corp (f/k/a Y Corp)",
+			legalform.Designation{Name: "X", LegalForm: "Corp", Relation: legalform.RelationFormerly, Connector: "f/k/a", OtherName: "Y", OtherLegalForm: "Corp"},
+		},
+		{
+			"Example SAS, anciennement Exemple SARL",
+			legalform.Designation{Name: "Example", LegalForm: "SAS", Relation: legalform.RelationFormerly, Connector: "anciennement", OtherName: "Exemple", OtherLegalForm: "SARL"},
+		},
+		{
+			"Example Ltd formerly known as Sample Ltd",
+			legalform.Designation{Name: "Example", LegalForm: "Ltd", Relation: legalform.RelationFormerly, Connector: "formerly known as", OtherName: "Sample", OtherLegalForm: "Ltd"},
+		},
+		{
+			"TA Associates Ltd",
+			legalform.Designation{Name: "TA Associates", LegalForm: "Ltd"},
+		},
+		{
+			"Example Ta Ltd",
+			legalform.Designation{Name: "Example Ta", LegalForm: "Ltd"},
+		},
+		{
+			"Oracle DBA Services Ltd",
+			legalform.Designation{Name: "Oracle DBA Services", LegalForm: "Ltd"},
+		},
+		{
+			"The Previously Loved Store Ltd",
+			legalform.Designation{Name: "The Previously Loved Store", LegalForm: "Ltd"},
+		},
+		{
+			"John Smith t/a Smith's Bakery",
+			legalform.Designation{Name: "John Smith", Relation: legalform.RelationTradingAs, Connector: "t/a", OtherName: "Smith's Bakery"},
+		},
+		{
+			"Example GmbH vormals",
+			legalform.Designation{Name: "Example GmbH vormals"},
+		},
+		{
+			"",
+			legalform.Designation{},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			assert.Equal(t, c.expected, legalform.Default.ParseDesignation(c.input))
+			assert.Equal(t, c.expected, legalform.DefaultMatcher().ParseDesignation(c.input))
+		})
+	}
+
+	assert.Equal(t, "trading as", legalform.RelationTradingAs.String())
+	assert.Equal(t, "none", legalform.Relation(42).String())
+}
@@ -0,0 +1,244 @@
+package legalform
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ELFStatus represents the status of an ISO 20275 entity legal form code.
+type ELFStatus string
+
+// The possible status of ISO 20275 entity legal form codes.
+const (
+	ELFStatusActive   ELFStatus = "ACTV"
+	ELFStatusInactive ELFStatus = "INAC"
+)
+
+// ELF describes an ISO 20275 entity legal form (ELF) as published by GLEIF.
+type ELF struct {
+	// Code is the four character ELF code, e.g. "2HBR".
+	Code string
+	// Country is the ISO 3166-1 country code of the jurisdiction.
+	Country string
+	// Subdivision is the ISO 3166-2 code of the country sub-division, if the
+	// legal form is only valid in parts of the country.
+	Subdivision string
+	// Name is the name of the legal form in the local language.
+	Name string
+	// TransliteratedName is the name of the legal form in Latin script.
+	TransliteratedName string
+	// Abbreviations are the local and transliterated abbreviations.
+	Abbreviations []string
+	// Status is the status of the ELF code.
+	Status ELFStatus
+}
+
+// ELFCodes represents a data structure that maps legal forms to their
+// ISO 20275 entity legal form codes.
+//
+// The first level key is the ISO country code, the second level key is a
+// cleaned legal form.
+//
+// No ELF codes are included in this package. Use ReadELFCodes with the ELF code
+// list CSV published by GLEIF, see
+// https://www.gleif.org/en/about-lei/code-lists/iso-20275-entity-legal-forms-code-list.
+type ELFCodes map[string]map[string][]ELF
+
+// NewELFCodes creates the ELF codes lookup for the provided entity legal
+// forms.
+//
+// Each entry can be found by its cleaned name, its cleaned transliterated name
+// and its cleaned abbreviations as well as by their aliases from
+// DefaultAliases.
+func NewELFCodes(elfs ...ELF) ELFCodes {
+	c := ELFCodes{}
+	for _, elf := range elfs {
+		country := elfCountry(elf.Country)
+		if c[country] == nil {
+			c[country] = map[string][]ELF{}
+		}
+		for _, key := range elfKeys(elf) {
+			if !slices.ContainsFunc(c[country][key], func(e ELF) bool { return e.Code == elf.Code }) {
+				c[country][key] = append(c[country][key], elf)
+			}
+		}
+	}
+	return c
+}
+
+func elfKeys(elf ELF) []string {
+	keys := elfNameKeys(elf)
+	for _, name := range elfNames(elf) {
+		if name != "" {
+			keys = append(keys, DefaultAliases.Find(elfAliasCountry(elf.Country), name))
+		}
+	}
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
+
+// elfNames returns the name, the transliterated name and the abbreviations of
+// the entity legal form.
+func elfNames(elf ELF) []string {
+	return append([]string{elf.Name, elf.TransliteratedName}, elf.Abbreviations...)
+}
+
+// Find returns the entity legal forms that match the legal form in the
+// country.
+//
+// The legal form can be provided as it was returned by Strip. If the cleaned
+// legal form itself is unknown, then its alias from DefaultAliases is used.
+// Active codes are returned before inactive ones. If no code was found, nil is
+// returned.
+func (c ELFCodes) Find(country, legalForm string) []ELF {
+	country = elfCountry(country)
+	elfs := c[country][cleanKey(legalForm)]
+	if len(elfs) == 0 {
+		elfs = c[country][DefaultAliases.Find(elfAliasCountry(country), legalForm)]
+	}
+	if len(elfs) == 0 {
+		return nil
+	}
+
+	elfs = slices.Clone(elfs)
+	slices.SortStableFunc(elfs, func(a, b ELF) int {
+		return strings.Compare(string(a.Status), string(b.Status))
+	})
+	return elfs
+}
+
+// elfCountry converts the country code into the ISO 3166-1 country code used
+// by the ELF code list, e.g. "UK" becomes "GB".
+func elfCountry(country string) string {
+	country = strings.ToUpper(country)
+	if country == "UK" {
+		return "GB"
+	}
+	return country
+}
+
+// elfAliasCountry converts the ISO 3166-1 country code of the ELF code list
+// into the country code used by DefaultAliases.
+func elfAliasCountry(country string) string {
+	country = strings.ToUpper(country)
+	if country == "GB" {
+		return "UK"
+	}
+	return country
+}
+
+// elfColumns maps the fields of ELF to the header of the corresponding column
+// in the ELF code list CSV published by GLEIF.
+var elfColumns = map[string]string{
+	"code":               "elf code",
+	"country":            "country code",
+	"subdivision":        "country sub-division code",
+	"name":               "local name",
+	"transliteratedName": "transliterated name",
+	"abbreviations":      "abbreviations local language",
+	"transliterated":     "abbreviations transliterated",
+	"status":             "elf status",
+}
+
+// ReadELFCodes reads the ELF code list CSV as published by GLEIF and returns
+// the ELF codes lookup.
+func ReadELFCodes(r io.Reader) (ELFCodes, error) {
+	elfs, err := ParseELFCSV(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewELFCodes(elfs...), nil
+}
+
+// ParseELFCSV reads the ELF code list CSV as published by GLEIF and returns
+// all its entries.
+//
+// The columns are identified by their headers, hence the order of the columns
+// does not matter and additional columns are ignored.
+func ParseELFCSV(r io.Reader) ([]ELF, error) {
+	reader := csv.NewReader(skipBOM(r))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ELF header: %w", err)
+	}
+	columns, err := elfColumnIndexes(header)
+	if err != nil {
+		return nil, err
+	}
+
+	elfs := []ELF{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return elfs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ELF record: %w", err)
+		}
+		elfs = append(elfs, newELF(record, columns))
+	}
+}
+
+// skipBOM removes the UTF-8 byte order mark the ELF code list starts with.
+func skipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if c, _, err := br.ReadRune(); err != nil || c != '\ufeff' {
+		_ = br.UnreadRune()
+	}
+	return br
+}
+
+func elfColumnIndexes(header []string) (map[string]int, error) {
+	columns := map[string]int{}
+	for field, name := range elfColumns {
+		for i, column := range header {
+			if strings.Contains(strings.ToLower(column), name) {
+				columns[field] = i
+				break
+			}
+		}
+	}
+	for _, field := range []string{"code", "country", "name"} {
+		if _, ok := columns[field]; !ok {
+			return nil, fmt.Errorf("missing ELF column %q", elfColumns[field])
+		}
+	}
+	return columns, nil
+}
+
+func newELF(record []string, columns map[string]int) ELF {
+	value := func(field string) string {
+		i, ok := columns[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	abbreviations := []string{}
+	for _, field := range []string{"abbreviations", "transliterated"} {
+		for _, abbreviation := range strings.Split(value(field), ";") {
+			abbreviation = strings.TrimSpace(abbreviation)
+			if abbreviation != "" && !slices.Contains(abbreviations, abbreviation) {
+				abbreviations = append(abbreviations, abbreviation)
+			}
+		}
+	}
+
+	return ELF{
+		Code:               value("code"),
+		Country:            strings.ToUpper(value("country")),
+		Subdivision:        value("subdivision"),
+		Name:               value("name"),
+		TransliteratedName: value("transliteratedName"),
+		Abbreviations:      abbreviations,
+		Status:             ELFStatus(strings.ToUpper(value("status"))),
+	}
+}
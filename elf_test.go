@@ -0,0 +1,106 @@
+package legalform_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestELFCodesFind(t *testing.T) {
+	cases := []struct {
+		country       string
+		legalForm     string
+		expectedCodes []string
+	}{
+		{
+			country:       "DE",
+			legalForm:     "GmbH",
+			expectedCodes: []string{"2HBR"},
+		},
+		{
+			country:       "de",
+			legalForm:     "G.m.b.H.",
+			expectedCodes: []string{"2HBR"},
+		},
+		{
+			country:       "DE",
+			legalForm:     "Gesellschaft mit beschränkter Haftung",
+			expectedCodes: []string{"2HBR"},
+		},
+		{
+			country:       "DE",
+			legalForm:     "GesmbH",
+			expectedCodes: []string{"2HBR"},
+		},
+		{
+			country:       "AT",
+			legalForm:     "GmbH",
+			expectedCodes: nil,
+		},
+		{
+			country:       "DE",
+			legalForm:     "Foobar",
+			expectedCodes: nil,
+		},
+	}
+
+	codes := legalform.NewELFCodes(legalform.ELF{
+		Code:          "2HBR",
+		Country:       "DE",
+		Name:          "Gesellschaft mit beschränkter Haftung",
+		Abbreviations: []string{"GmbH"},
+		Status:        legalform.ELFStatusActive,
+	})
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			var actual []string
+			for _, elf := range codes.Find(c.country, c.legalForm) {
+				actual = append(actual, elf.Code)
+			}
+			assert.Equal(t, c.expectedCodes, actual)
+		})
+	}
+}
+
+// The codes other than 2HBR are made up for testing purposes.
+const testELFCSV = "\ufeff\"ELF Code\",\"Country of formation\",\"Country Code (ISO 3166-1)\",\"Country sub-division code (ISO 3166-2)\",\"Entity Legal Form name Local name\",\"Entity Legal Form name Transliterated name (per ISO 01-140-10)\",\"Abbreviations Local language\",\"Abbreviations transliterated\",\"ELF Status ACTV/INAC\"\n" +
+	"2HBR,Germany,DE,,Gesellschaft mit beschränkter Haftung,,GmbH;gGmbH,,ACTV\n" +
+	"T001,Germany,DE,,Gesellschaft mit beschränkter Haftung alt,,GmbH,,INAC\n" +
+	"T002,United Kingdom,GB,,Private limited company,,Ltd;Limited,,ACTV\n"
+
+func TestReadELFCodes(t *testing.T) {
+	codes, err := legalform.ReadELFCodes(strings.NewReader(testELFCSV))
+	assert.NoError(t, err)
+
+	actual := codes.Find("DE", "GmbH")
+	if assert.Len(t, actual, 2) {
+		assert.Equal(t, "2HBR", actual[0].Code)
+		assert.Equal(t, legalform.ELFStatusActive, actual[0].Status)
+		assert.Equal(t, []string{"GmbH", "gGmbH"}, actual[0].Abbreviations)
+		assert.Equal(t, "T001", actual[1].Code)
+		assert.Equal(t, legalform.ELFStatusInactive, actual[1].Status)
+	}
+
+	actual = codes.Find("UK", "Limited")
+	if assert.Len(t, actual, 1) {
+		assert.Equal(t, "T002", actual[0].Code)
+		assert.Equal(t, "GB", actual[0].Country)
+	}
+
+	for _, legalForm := range []string{"Ltd.", "L.T.D.", "Private Limited Company"} {
+		actual = codes.Find("GB", legalForm)
+		if assert.Len(t, actual, 1, legalForm) {
+			assert.Equal(t, "T002", actual[0].Code)
+		}
+	}
+	assert.Nil(t, codes.Find("DE", "Ltd"))
+	assert.Nil(t, codes.Find("US", "Limited"))
+}
+
+func TestReadELFCodesMissingColumn(t *testing.T) {
+	_, err := legalform.ReadELFCodes(strings.NewReader("Code,Name\n2HBR,GmbH\n"))
+	assert.Error(t, err)
+}
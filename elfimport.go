@@ -0,0 +1,159 @@
+package legalform
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// ELFImport represents the legal forms and aliases derived from the ISO 20275
+// entity legal forms.
+type ELFImport struct {
+	LegalForms LegalForms
+	Aliases    Aliases
+	// Conflicts contains the names and abbreviations that were used for
+	// different entity legal forms within the same country. No alias is
+	// created for them.
+	Conflicts []ELFConflict
+}
+
+// ELFConflict describes a name or abbreviation that is used for different
+// entity legal forms within the same country.
+type ELFConflict struct {
+	Country   string
+	LegalForm string
+	Aliases   []string
+	Codes     []string
+}
+
+// String returns a human readable description of the conflict.
+func (c ELFConflict) String() string {
+	return fmt.Sprintf("%v %v: %v (%v)", c.Country, c.LegalForm, c.Aliases, c.Codes)
+}
+
+// ImportELF derives the legal forms and the country specific aliases from the
+// provided entity legal forms.
+//
+// The same cleaning and normalization rules as for Strip are applied. Every
+// name and abbreviation becomes a legal form. Within a country, each of them
+// becomes an alias for the first abbreviation of the entity legal form or for
+// its name if it has no abbreviations.
+func ImportELF(elfs ...ELF) ELFImport {
+	legalForms := LegalForms{}
+	targets := map[string]map[string]map[string][]string{}
+	for _, elf := range elfs {
+		country := elfAliasCountry(elf.Country)
+		if targets[country] == nil {
+			targets[country] = map[string]map[string][]string{}
+		}
+		alias := elfAlias(elf)
+		for _, key := range elfNameKeys(elf) {
+			legalForms[key] = struct{}{}
+			if targets[country][key] == nil {
+				targets[country][key] = map[string][]string{}
+			}
+			if !slices.Contains(targets[country][key][alias], elf.Code) {
+				targets[country][key][alias] = append(targets[country][key][alias], elf.Code)
+			}
+		}
+	}
+
+	result := ELFImport{
+		LegalForms: legalForms,
+		Aliases:    Aliases{},
+	}
+	for _, country := range slices.Sorted(maps.Keys(targets)) {
+		for _, key := range slices.Sorted(maps.Keys(targets[country])) {
+			result.addAlias(country, key, targets[country][key])
+		}
+	}
+	return result
+}
+
+func (i *ELFImport) addAlias(country, key string, aliases map[string][]string) {
+	if len(aliases) > 1 {
+		conflict := ELFConflict{
+			Country:   country,
+			LegalForm: key,
+			Aliases:   slices.Sorted(maps.Keys(aliases)),
+		}
+		for _, alias := range conflict.Aliases {
+			conflict.Codes = append(conflict.Codes, aliases[alias]...)
+		}
+		i.Conflicts = append(i.Conflicts, conflict)
+		return
+	}
+	for alias := range aliases {
+		if alias == key {
+			return
+		}
+		if i.Aliases[country] == nil {
+			i.Aliases[country] = map[string]string{}
+		}
+		i.Aliases[country][key] = alias
+	}
+}
+
+func elfAlias(elf ELF) string {
+	for _, abbreviation := range elf.Abbreviations {
+		if key := cleanKey(abbreviation); key != "" {
+			return key
+		}
+	}
+	if key := cleanKey(elf.Name); key != "" {
+		return key
+	}
+	return cleanKey(elf.TransliteratedName)
+}
+
+func elfNameKeys(elf ELF) []string {
+	keys := []string{}
+	for _, name := range elfNames(elf) {
+		if key := cleanKey(name); key != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Diff returns the legal forms that are only in f (added) and the legal forms
+// that are only in other (removed).
+func (f LegalForms) Diff(other LegalForms) ([]string, []string) {
+	added := []string{}
+	for key := range f {
+		if _, ok := other[key]; !ok {
+			added = append(added, key)
+		}
+	}
+	removed := []string{}
+	for key := range other {
+		if _, ok := f[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	return added, removed
+}
+
+// Diff returns the aliases that are only in l (added) and the aliases that are
+// only in other (removed).
+//
+// Each alias is described in the form "country legalform -> alias". An alias
+// that was changed is reported as both removed and added.
+func (l Aliases) Diff(other Aliases) ([]string, []string) {
+	return l.missingIn(other), other.missingIn(l)
+}
+
+func (l Aliases) missingIn(other Aliases) []string {
+	missing := []string{}
+	for country, aliases := range l {
+		for legalForm, alias := range aliases {
+			if other[country][legalForm] != alias {
+				missing = append(missing, fmt.Sprintf("%v %v -> %v", country, legalForm, alias))
+			}
+		}
+	}
+	slices.Sort(missing)
+	return missing
+}
@@ -0,0 +1,51 @@
+package legalform_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestImportELF(t *testing.T) {
+	// The codes are made up for testing purposes.
+	actual := legalform.ImportELF(
+		legalform.ELF{Code: "T001", Country: "DE", Name: "Gesellschaft mit beschränkter Haftung", Abbreviations: []string{"GmbH"}},
+		legalform.ELF{Code: "T002", Country: "DE", Name: "Aktiengesellschaft", Abbreviations: []string{"AG"}},
+		legalform.ELF{Code: "T003", Country: "DE", Name: "Kommanditgesellschaft", Abbreviations: []string{"KG"}},
+		legalform.ELF{Code: "T004", Country: "DE", Name: "Kommanditgesellschaft auf Aktien", Abbreviations: []string{"KGaA", "KG"}},
+		legalform.ELF{Code: "T005", Country: "GB", Name: "Private limited company", Abbreviations: []string{"Ltd."}},
+	)
+
+	assert.Equal(t, legalform.LegalForms{
+		"gesellschaftmitbeschrankterhaftung": struct{}{},
+		"gmbh":                               struct{}{},
+		"aktiengesellschaft":                 struct{}{},
+		"ag":                                 struct{}{},
+		"kommanditgesellschaft":              struct{}{},
+		"kg":                                 struct{}{},
+		"kommanditgesellschaftaufaktien":     struct{}{},
+		"kgaa":                               struct{}{},
+		"privatelimitedcompany":              struct{}{},
+		"ltd":                                struct{}{},
+	}, actual.LegalForms)
+	assert.Equal(t, legalform.Aliases{
+		"DE": map[string]string{
+			"gesellschaftmitbeschrankterhaftung": "gmbh",
+			"aktiengesellschaft":                 "ag",
+			"kommanditgesellschaft":              "kg",
+			"kommanditgesellschaftaufaktien":     "kgaa",
+		},
+		"UK": map[string]string{
+			"privatelimitedcompany": "ltd",
+		},
+	}, actual.Aliases)
+	assert.Equal(t, []legalform.ELFConflict{
+		{
+			Country:   "DE",
+			LegalForm: "kg",
+			Aliases:   []string{"kg", "kgaa"},
+			Codes:     []string{"T003", "T004"},
+		},
+	}, actual.Conflicts)
+}
@@ -0,0 +1,247 @@
+package legalform
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format represents the format of a data file containing legal forms or
+// aliases.
+type Format int
+
+// The supported data file formats.
+const (
+	FormatJSON Format = iota
+	FormatYAML
+)
+
+// FormatOf returns the format of the data file based on its extension.
+func FormatOf(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON, nil
+	case ".yaml", ".yml":
+		return FormatYAML, nil
+	}
+	return 0, fmt.Errorf("unsupported file format %q", filepath.Ext(path))
+}
+
+// ReadLegalForms reads the legal forms in the provided format.
+//
+// The data is expected to be a list of legal forms. The legal forms are
+// cleaned the same way as they are when stripping, hence they can be provided
+// as they would appear in a company name, e.g. "S.A.".
+func ReadLegalForms(r io.Reader, format Format) (LegalForms, error) {
+	f := LegalForms{}
+	err := decode(r, format, &f)
+	return f, err
+}
+
+// LoadLegalForms reads the legal forms from the JSON or YAML file.
+func LoadLegalForms(path string) (LegalForms, error) {
+	f := LegalForms{}
+	err := load(path, &f)
+	return f, err
+}
+
+// Write writes the legal forms in the provided format.
+func (f LegalForms) Write(w io.Writer, format Format) error {
+	return encode(w, format, f)
+}
+
+// Save writes the legal forms into the JSON or YAML file.
+func (f LegalForms) Save(path string) error {
+	return save(path, f)
+}
+
+// Merge returns a new instance containing the legal forms of f and all others.
+func (f LegalForms) Merge(others ...LegalForms) LegalForms {
+	merged := maps.Clone(f)
+	if merged == nil {
+		merged = LegalForms{}
+	}
+	for _, other := range others {
+		maps.Copy(merged, other)
+	}
+	return merged
+}
+
+// MarshalJSON encodes the legal forms as a sorted list.
+func (f LegalForms) MarshalJSON() ([]byte, error) {
+	return json.Marshal(slices.Sorted(maps.Keys(f)))
+}
+
+// UnmarshalJSON decodes the legal forms from a list and cleans them.
+func (f *LegalForms) UnmarshalJSON(data []byte) error {
+	forms := []string{}
+	if err := json.Unmarshal(data, &forms); err != nil {
+		return err
+	}
+	*f = NewLegalForms(forms...)
+	return nil
+}
+
+// MarshalYAML encodes the legal forms as a sorted list.
+func (f LegalForms) MarshalYAML() (any, error) {
+	return slices.Sorted(maps.Keys(f)), nil
+}
+
+// UnmarshalYAML decodes the legal forms from a list and cleans them.
+func (f *LegalForms) UnmarshalYAML(value *yaml.Node) error {
+	forms := []string{}
+	if err := value.Decode(&forms); err != nil {
+		return err
+	}
+	*f = NewLegalForms(forms...)
+	return nil
+}
+
+// ReadAliases reads the aliases in the provided format.
+//
+// The data is expected to be a mapping from countries to a mapping from legal
+// forms to their aliases. Countries, legal forms and aliases are cleaned the
+// same way as DefaultAliases.
+func ReadAliases(r io.Reader, format Format) (Aliases, error) {
+	l := Aliases{}
+	err := decode(r, format, &l)
+	return l, err
+}
+
+// LoadAliases reads the aliases from the JSON or YAML file.
+func LoadAliases(path string) (Aliases, error) {
+	l := Aliases{}
+	err := load(path, &l)
+	return l, err
+}
+
+// Write writes the aliases in the provided format.
+func (l Aliases) Write(w io.Writer, format Format) error {
+	return encode(w, format, l)
+}
+
+// Save writes the aliases into the JSON or YAML file.
+func (l Aliases) Save(path string) error {
+	return save(path, l)
+}
+
+// Merge returns a new instance containing the aliases of l and all others.
+//
+// If the same legal form has an alias in multiple instances for the same
+// country, then the alias of the last instance wins.
+func (l Aliases) Merge(others ...Aliases) Aliases {
+	merged := Aliases{}
+	for _, aliases := range append([]Aliases{l}, others...) {
+		for country, countryAliases := range aliases {
+			if merged[country] == nil {
+				merged[country] = map[string]string{}
+			}
+			maps.Copy(merged[country], countryAliases)
+		}
+	}
+	return merged
+}
+
+// UnmarshalJSON decodes the aliases and cleans them.
+func (l *Aliases) UnmarshalJSON(data []byte) error {
+	aliases := map[string]map[string]string{}
+	if err := json.Unmarshal(data, &aliases); err != nil {
+		return err
+	}
+	*l = cleanAliases(aliases)
+	return nil
+}
+
+// UnmarshalYAML decodes the aliases and cleans them.
+func (l *Aliases) UnmarshalYAML(value *yaml.Node) error {
+	aliases := map[string]map[string]string{}
+	if err := value.Decode(&aliases); err != nil {
+		return err
+	}
+	*l = cleanAliases(aliases)
+	return nil
+}
+
+func cleanAliases(aliases map[string]map[string]string) Aliases {
+	l := Aliases{}
+	for country, countryAliases := range aliases {
+		country = strings.ToUpper(country)
+		if l[country] == nil {
+			l[country] = map[string]string{}
+		}
+		for legalForm, alias := range countryAliases {
+			l[country][cleanKey(legalForm)] = cleanKey(alias)
+		}
+	}
+	return l
+}
+
+func decode(r io.Reader, format Format, v any) error {
+	var err error
+	switch format {
+	case FormatJSON:
+		err = json.NewDecoder(r).Decode(v)
+	case FormatYAML:
+		err = yaml.NewDecoder(r).Decode(v)
+	default:
+		return fmt.Errorf("unsupported format %v", format)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to decode: %w", err)
+	}
+	return nil
+}
+
+func encode(w io.Writer, format Format, v any) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(v)
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+		return encoder.Close()
+	}
+	return fmt.Errorf("unsupported format %v", format)
+}
+
+func load(path string, v any) error {
+	format, err := FormatOf(path)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return decode(f, format, v)
+}
+
+func save(path string, v any) error {
+	format, err := FormatOf(path)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := encode(f, format, v); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
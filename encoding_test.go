@@ -0,0 +1,135 @@
+package legalform_test
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestReadLegalForms(t *testing.T) {
+	cases := []struct {
+		format legalform.Format
+		input  string
+	}{
+		{
+			format: legalform.FormatJSON,
+			input:  `["S.A.", "GmbH", "Société Coopérative", ""]`,
+		},
+		{
+			format: legalform.FormatYAML,
+			input:  "- S.A.\n- GmbH\n- Société Coopérative\n",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			actual, err := legalform.ReadLegalForms(strings.NewReader(c.input), c.format)
+			assert.NoError(t, err)
+			assert.Equal(t, legalform.LegalForms{
+				"sa":                 struct{}{},
+				"gmbh":               struct{}{},
+				"societecooperative": struct{}{},
+			}, actual)
+		})
+	}
+}
+
+func TestReadAliases(t *testing.T) {
+	cases := []struct {
+		format legalform.Format
+		input  string
+	}{
+		{
+			format: legalform.FormatJSON,
+			input:  `{"de": {"Gesellschaft mit beschränkter Haftung": "GmbH"}}`,
+		},
+		{
+			format: legalform.FormatYAML,
+			input:  "de:\n  Gesellschaft mit beschränkter Haftung: GmbH\n",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			actual, err := legalform.ReadAliases(strings.NewReader(c.input), c.format)
+			assert.NoError(t, err)
+			assert.Equal(t, legalform.Aliases{
+				"DE": map[string]string{
+					"gesellschaftmitbeschrankterhaftung": "gmbh",
+				},
+			}, actual)
+			assert.Equal(t, "gmbh", actual.Find("DE", "Gesellschaft mit beschränkter Haftung"))
+		})
+	}
+}
+
+func TestReadInvalidData(t *testing.T) {
+	_, err := legalform.ReadLegalForms(strings.NewReader(`{"foo": "bar"}`), legalform.FormatJSON)
+	assert.Error(t, err)
+	_, err = legalform.ReadAliases(strings.NewReader("- foo\n"), legalform.FormatYAML)
+	assert.Error(t, err)
+}
+
+func TestWriteAndReadLegalForms(t *testing.T) {
+	forms := legalform.LegalForms{"gmbh": struct{}{}, "ag": struct{}{}}
+	for _, format := range []legalform.Format{legalform.FormatJSON, legalform.FormatYAML} {
+		buf := bytes.Buffer{}
+		assert.NoError(t, forms.Write(&buf, format))
+		actual, err := legalform.ReadLegalForms(&buf, format)
+		assert.NoError(t, err)
+		assert.Equal(t, forms, actual)
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	customForms := legalform.LegalForms{"gmbh": struct{}{}, "ltd": struct{}{}}
+	customAliases := legalform.Aliases{
+		"DE": map[string]string{"gesellschaftmitbeschrankterhaftung": "gmbh"},
+		"*":  map[string]string{"limited": "ltd"},
+	}
+	for _, name := range []string{"data.json", "data.yaml", "data.yml"} {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, customForms.Save(path))
+		forms, err := legalform.LoadLegalForms(path)
+		assert.NoError(t, err)
+		assert.Equal(t, customForms, forms)
+
+		assert.NoError(t, customAliases.Save(path))
+		aliases, err := legalform.LoadAliases(path)
+		assert.NoError(t, err)
+		assert.Equal(t, customAliases, aliases)
+	}
+
+	assert.Error(t, customForms.Save(filepath.Join(dir, "data.txt")))
+	_, err := legalform.LoadLegalForms(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestMergeLegalForms(t *testing.T) {
+	custom := legalform.LegalForms{"foobar": struct{}{}}
+	merged := legalform.Default.Merge(custom)
+	assert.Len(t, merged, len(legalform.Default)+1)
+	assert.NotContains(t, legalform.Default, "foobar")
+
+	name, legalForm := merged.Strip("Example Foobar")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "Foobar", legalForm)
+}
+
+func TestMergeAliases(t *testing.T) {
+	custom := legalform.Aliases{
+		"DE": map[string]string{"gmbh": "limited"},
+		"XX": map[string]string{"foo": "bar"},
+	}
+	merged := legalform.DefaultAliases.Merge(custom)
+	assert.Equal(t, "limited", merged.Find("DE", "GmbH"))
+	assert.Equal(t, "ag", merged.Find("DE", "Aktiengesellschaft"))
+	assert.Equal(t, "bar", merged.Find("XX", "Foo"))
+	assert.Equal(t, "gmbh", legalform.DefaultAliases.Find("DE", "GmbH"))
+}
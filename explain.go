@@ -0,0 +1,272 @@
+package legalform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tilotech/go-phonetics/diacrit"
+)
+
+// Explanation describes how Strip processed a full company name. It is meant
+// for debugging unexpected results and for bug reports.
+type Explanation struct {
+	// Input is the full company name.
+	Input string
+	// Tokens are the parts of the full company name as they were written.
+	Tokens []string
+	// NormalizedTokens are the tokens without diacritics, e.g. "Societe" for
+	// "Société".
+	NormalizedTokens []string
+	// CleanTokens are the normalized tokens in lower case and without
+	// punctuation that are used to build the keys.
+	CleanTokens []string
+	// Splits are the tokens that were not separated from the previous token by
+	// a white space, e.g. "GmbH" of "Example-GmbH" or "株式会社" of
+	// "トヨタ株式会社".
+	Splits []Split
+	// Probes are the keys that were looked up, starting with the last token.
+	Probes []Probe
+	// Start is the index of the first token of the legal form. It is equal to
+	// the number of tokens if no legal form was found.
+	Start int
+	// Reason tells why the legal form starts at Start.
+	Reason Reason
+	// Name is the plain company name as returned by Strip.
+	Name string
+	// LegalForm is the legal form as returned by Strip.
+	LegalForm string
+	// Key is the key of the legal form that matched.
+	Key string
+}
+
+// Split is a token that was split from the previous token, because it is a
+// legal form that is attached by punctuation, glued to a script without white
+// spaces or, if enabled, attached by a change of the case.
+type Split struct {
+	// Token is the index of the token.
+	Token int
+	// Separator is the punctuation between the previous token and the token,
+	// e.g. "-" for "Example-GmbH". It is empty if both were glued together.
+	Separator string
+}
+
+// Probe is a single key that was looked up for a sequence of tokens.
+type Probe struct {
+	// Start is the index of the first token of the key.
+	Start int
+	// End is the index after the last token of the key.
+	End int
+	// Key is the key that was looked up.
+	Key string
+	// Hit is true if the key is a legal form.
+	Hit bool
+	// Guarded is true if the key is a legal form, but it was skipped by the
+	// ambiguity guard, see WithAmbiguityGuard.
+	Guarded bool
+	// Excluded is true if the key is a legal form, but it is not used in the
+	// country. Excluded keys are no hits.
+	Excluded bool
+}
+
+// Reason describes why the legal form of an Explanation was chosen.
+type Reason int
+
+// Possible reasons for the chosen legal form.
+const (
+	// ReasonNone means that there is no legal form at the end of the name.
+	ReasonNone Reason = iota
+	// ReasonLongest means that the longest legal form at the end of the name
+	// was chosen.
+	ReasonLongest
+	// ReasonCountry means that longer legal forms were not chosen, because
+	// they are not used in the country.
+	ReasonCountry
+	// ReasonGuard means that longer legal forms were skipped by the ambiguity
+	// guard.
+	ReasonGuard
+)
+
+var reasonNames = map[Reason]string{
+	ReasonNone:    "none",
+	ReasonLongest: "longest",
+	ReasonCountry: "country",
+	ReasonGuard:   "guard",
+}
+
+var reasonDescriptions = map[Reason]string{
+	ReasonNone:    "no legal form at the end of the name",
+	ReasonLongest: "longest legal form at the end of the name",
+	ReasonCountry: "longer legal forms are not used in the country",
+	ReasonGuard:   "longer legal forms were skipped by the ambiguity guard",
+}
+
+// String returns the English name of the reason.
+func (r Reason) String() string {
+	if name, ok := reasonNames[r]; ok {
+		return name
+	}
+	return reasonNames[ReasonNone]
+}
+
+// Explain strips the legal form from the end of the full company name like
+// Strip and describes every step that led to the result.
+func (f LegalForms) Explain(fullName string) Explanation {
+	return explain(f, f, fullName, nil)
+}
+
+// ExplainForCountry strips the legal form from the end of the full company
+// name like StripForCountry and describes every step that led to the result.
+func (f LegalForms) ExplainForCountry(country, fullName string) Explanation {
+	return explain(f.forCountry(country), f, fullName, nil)
+}
+
+// Explain strips the legal form from the end of the full company name like
+// Strip and describes every step that led to the result. The result honors
+// WithAmbiguityGuard, while the probes list every key that was looked up.
+func (m *Matcher) Explain(fullName string) Explanation {
+	return m.explain(m, "", fullName)
+}
+
+// ExplainForCountry strips the legal form from the end of the full company
+// name like StripForCountry and describes every step that led to the result.
+func (m *Matcher) ExplainForCountry(country, fullName string) Explanation {
+	return m.explain(m.forCountry(country), country, fullName)
+}
+
+// explain explains the result of Matcher.strip.
+func (m *Matcher) explain(idx index, country, fullName string) Explanation {
+	var guard func([]token, []string, int) bool
+	if m.guard {
+		country = elfAliasCountry(country)
+		guard = func(tokens []token, cleanTokens []string, start int) bool {
+			return guarded(m.countries, country, fullName, tokens, cleanTokens, start)
+		}
+	}
+	e := explain(idx, m, fullName, guard)
+	e.Name = m.trim(e.Name)
+	return e
+}
+
+// explain describes how the legal form is stripped using the index. The keys
+// that are no hits of the index, but of all, are excluded. If guard is not
+// nil, then it reports which hits are skipped by the ambiguity guard.
+func explain(idx, all index, fullName string, guard func([]token, []string, int) bool) Explanation {
+	tokens := tokenize(idx, fullName)
+	cleanTokens := cleanTokens(tokens)
+	e := Explanation{
+		Input:            fullName,
+		Tokens:           make([]string, len(tokens)),
+		NormalizedTokens: make([]string, len(tokens)),
+		CleanTokens:      cleanTokens,
+		Start:            len(tokens),
+	}
+	for i, t := range tokens {
+		e.Tokens[i] = t.text
+		e.NormalizedTokens[i] = diacrit.Normalize(t.text)
+		if i == 0 {
+			continue
+		}
+		if gap := fullName[tokens[i-1].end:t.start]; isAttaching(gap) {
+			e.Splits = append(e.Splits, Split{Token: i, Separator: gap})
+		}
+	}
+
+	// The first token is never considered to be part of the legal form.
+	for start := len(cleanTokens) - 1; start > 0; start-- {
+		key := strings.Join(cleanTokens[start:], "")
+		p := Probe{Start: start, End: len(cleanTokens), Key: key, Hit: idx.has(key)}
+		switch {
+		case !p.Hit:
+			p.Excluded = all.has(key)
+		case guard != nil:
+			p.Guarded = guard(tokens, cleanTokens, start)
+		}
+		e.Probes = append(e.Probes, p)
+	}
+
+	// The longest hit that is not guarded wins. If a longer legal form was
+	// skipped, then the longest of them tells why.
+	for i := len(e.Probes) - 1; i >= 0 && e.Start == len(tokens); i-- {
+		p := e.Probes[i]
+		if p.Hit && !p.Guarded {
+			e.Start = p.Start
+		}
+		if e.Reason != ReasonNone {
+			continue
+		}
+		switch {
+		case p.Guarded:
+			e.Reason = ReasonGuard
+		case p.Excluded:
+			e.Reason = ReasonCountry
+		case p.Hit:
+			e.Reason = ReasonLongest
+		}
+	}
+
+	e.Name = join(tokens[:e.Start], fullName)
+	e.LegalForm = join(tokens[e.Start:], fullName)
+	e.Key = strings.Join(cleanTokens[e.Start:], "")
+	return e
+}
+
+// String returns a human readable representation of the explanation.
+func (e Explanation) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "input:        %q\n", e.Input)
+	fmt.Fprintf(&sb, "tokens:       %v\n", quoteAll(e.Tokens))
+	fmt.Fprintf(&sb, "normalized:   %v\n", quoteAll(e.NormalizedTokens))
+	fmt.Fprintf(&sb, "clean tokens: %v\n", quoteAll(e.CleanTokens))
+	if len(e.Splits) == 0 {
+		sb.WriteString("splits:       none\n")
+	} else {
+		sb.WriteString("splits:\n")
+	}
+	for _, s := range e.Splits {
+		fmt.Fprintf(&sb, "  token %v  %v\n", s.Token, e.splitting(s))
+	}
+	sb.WriteString("probes:\n")
+	if len(e.Probes) == 0 {
+		sb.WriteString("  none, the first token is never a legal form\n")
+	}
+	for _, p := range e.Probes {
+		result := "miss"
+		switch {
+		case p.Guarded:
+			result = "guarded"
+		case p.Hit:
+			result = "hit"
+		case p.Excluded:
+			result = "excluded"
+		}
+		fmt.Fprintf(&sb, "  tokens %v-%v  %-8v %q\n", p.Start, p.End-1, result, p.Key)
+	}
+	if e.Key == "" {
+		fmt.Fprintf(&sb, "result:       no legal form, name %q\n", e.Name)
+	} else {
+		fmt.Fprintf(&sb, "result:       legal form at token %v, name %q, legal form %q, key %q\n", e.Start, e.Name, e.LegalForm, e.Key)
+	}
+	fmt.Fprintf(&sb, "reason:       %v\n", reasonDescriptions[e.Reason])
+	return sb.String()
+}
+
+// splitting describes why the token was split from the previous token.
+func (e Explanation) splitting(s Split) string {
+	switch {
+	case s.Separator != "":
+		return fmt.Sprintf("attached by %q", s.Separator)
+	case s.Token < len(e.Tokens) && (strings.ContainsFunc(e.Tokens[s.Token], isUnspaced) ||
+		strings.ContainsFunc(e.Tokens[s.Token-1], isUnspaced)):
+		return "glued to a script without white spaces"
+	default:
+		return "attached by a change of the case"
+	}
+}
+
+func quoteAll(s []string) string {
+	quoted := make([]string, len(s))
+	for i, v := range s {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,169 @@
+package legalform_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestExplain(t *testing.T) {
+	forms := legalform.NewLegalForms("GmbH", "KG", "GmbH & Co. KG")
+	expected := legalform.Explanation{
+		Input:            "Example GmbH & Co. KG",
+		Tokens:           []string{"Example", "GmbH", "&", "Co.", "KG"},
+		NormalizedTokens: []string{"Example", "GmbH", "&", "Co.", "KG"},
+		CleanTokens:      []string{"example", "gmbh", "", "co", "kg"},
+		Probes: []legalform.Probe{
+			{Start: 4, End: 5, Key: "kg", Hit: true},
+			{Start: 3, End: 5, Key: "cokg"},
+			{Start: 2, End: 5, Key: "cokg"},
+			{Start: 1, End: 5, Key: "gmbhcokg", Hit: true},
+		},
+		Start:     1,
+		Reason:    legalform.ReasonLongest,
+		Name:      "Example",
+		LegalForm: "GmbH & Co. KG",
+		Key:       "gmbhcokg",
+	}
+
+	assert.Equal(t, expected, forms.Explain("Example GmbH & Co. KG"))
+	assert.Equal(t, expected, legalform.NewMatcher(forms).Explain("Example GmbH & Co. KG"))
+
+	name, legalForm := forms.Strip("Example GmbH & Co. KG")
+	assert.Equal(t, name, expected.Name)
+	assert.Equal(t, legalForm, expected.LegalForm)
+}
+
+func TestExplainNormalizationAndSplits(t *testing.T) {
+	e := legalform.Default.Explain("Müller-GmbH")
+	assert.Equal(t, []string{"Müller", "GmbH"}, e.Tokens)
+	assert.Equal(t, []string{"Muller", "GmbH"}, e.NormalizedTokens)
+	assert.Equal(t, []string{"muller", "gmbh"}, e.CleanTokens)
+	assert.Equal(t, []legalform.Split{{Token: 1, Separator: "-"}}, e.Splits)
+
+	e = legalform.Default.Explain("トヨタ株式会社")
+	assert.Equal(t, []string{"トヨタ", "株式会社"}, e.Tokens)
+	assert.Equal(t, []legalform.Split{{Token: 1}}, e.Splits)
+	assert.Equal(t, legalform.ReasonLongest, e.Reason)
+}
+
+func TestExplainGuard(t *testing.T) {
+	m := legalform.NewMatcher(legalform.NewLegalForms("AS", "Co", "Co AS"), legalform.WithAmbiguityGuard())
+
+	e := m.Explain("Foo Bar Co as")
+	assert.Equal(t, []legalform.Probe{
+		{Start: 3, End: 4, Key: "as", Hit: true, Guarded: true},
+		{Start: 2, End: 4, Key: "coas", Hit: true},
+	}, e.Probes[:2])
+	assert.Equal(t, 2, e.Start)
+	assert.Equal(t, legalform.ReasonLongest, e.Reason)
+
+	e = m.Explain("Foo Bar as")
+	assert.Equal(t, 3, e.Start)
+	assert.Equal(t, legalform.ReasonGuard, e.Reason)
+	assert.Equal(t, "Foo Bar as", e.Name)
+	name, legalForm := m.Strip("Foo Bar as")
+	assert.Equal(t, name, e.Name)
+	assert.Equal(t, legalForm, e.LegalForm)
+
+	e = m.ExplainForCountry("NO", "Foo Bar as")
+	assert.Equal(t, 2, e.Start)
+	assert.Equal(t, legalform.ReasonLongest, e.Reason)
+}
+
+func TestExplainForCountry(t *testing.T) {
+	e := legalform.Default.ExplainForCountry("US", "Example AG")
+	assert.Equal(t, []legalform.Probe{{Start: 1, End: 2, Key: "ag", Excluded: true}}, e.Probes)
+	assert.Equal(t, 2, e.Start)
+	assert.Equal(t, legalform.ReasonCountry, e.Reason)
+
+	e = legalform.DefaultMatcher().ExplainForCountry("US", "Example GmbH LLC")
+	assert.Equal(t, "Example GmbH", e.Name)
+	assert.Equal(t, "LLC", e.LegalForm)
+	assert.Equal(t, legalform.ReasonLongest, e.Reason)
+
+	e = legalform.Default.ExplainForCountry("DE", "Example AG")
+	assert.Equal(t, "AG", e.LegalForm)
+	assert.Equal(t, legalform.ReasonLongest, e.Reason)
+}
+
+func TestExplanationString(t *testing.T) {
+	forms := legalform.NewLegalForms("GmbH")
+
+	expected := `input:        "Example GmbH"
+tokens:       "Example" "GmbH"
+normalized:   "Example" "GmbH"
+clean tokens: "example" "gmbh"
+splits:       none
+probes:
+  tokens 1-1  hit      "gmbh"
+result:       legal form at token 1, name "Example", legal form "GmbH", key "gmbh"
+reason:       longest legal form at the end of the name
+`
+	assert.Equal(t, expected, forms.Explain("Example GmbH").String())
+
+	expected = `input:        "Example"
+tokens:       "Example"
+normalized:   "Example"
+clean tokens: "example"
+splits:       none
+probes:
+  none, the first token is never a legal form
+result:       no legal form, name "Example"
+reason:       no legal form at the end of the name
+`
+	assert.Equal(t, expected, forms.Explain("Example").String())
+
+	expected = `input:        "Example Ltd"
+tokens:       "Example" "Ltd"
+normalized:   "Example" "Ltd"
+clean tokens: "example" "ltd"
+splits:       none
+probes:
+  tokens 1-1  miss     "ltd"
+result:       no legal form, name "Example Ltd"
+reason:       no legal form at the end of the name
+`
+	assert.Equal(t, expected, forms.Explain("Example Ltd").String())
+
+	expected = `input:        "Société-GmbH"
+tokens:       "Société" "GmbH"
+normalized:   "Societe" "GmbH"
+clean tokens: "societe" "gmbh"
+splits:
+  token 1  attached by "-"
+probes:
+  tokens 1-1  hit      "gmbh"
+result:       legal form at token 1, name "Société", legal form "GmbH", key "gmbh"
+reason:       longest legal form at the end of the name
+`
+	assert.Equal(t, expected, forms.Explain("Société-GmbH").String())
+
+	guarded := legalform.NewMatcher(legalform.NewLegalForms("AS"), legalform.WithAmbiguityGuard())
+	expected = `input:        "Foo Bar as"
+tokens:       "Foo" "Bar" "as"
+normalized:   "Foo" "Bar" "as"
+clean tokens: "foo" "bar" "as"
+splits:       none
+probes:
+  tokens 2-2  guarded  "as"
+  tokens 1-2  miss     "baras"
+result:       no legal form, name "Foo Bar as"
+reason:       longer legal forms were skipped by the ambiguity guard
+`
+	assert.Equal(t, expected, guarded.Explain("Foo Bar as").String())
+
+	expected = `input:        "トヨタ株式会社"
+tokens:       "トヨタ" "株式会社"
+normalized:   "トヨタ" "株式会社"
+clean tokens: "トヨタ" "株式会社"
+splits:
+  token 1  glued to a script without white spaces
+probes:
+  tokens 1-1  hit      "株式会社"
+result:       legal form at token 1, name "トヨタ", legal form "株式会社", key "株式会社"
+reason:       longest legal form at the end of the name
+`
+	assert.Equal(t, expected, legalform.NewLegalForms("株式会社").Explain("トヨタ株式会社").String())
+}
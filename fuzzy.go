@@ -0,0 +1,302 @@
+package legalform
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxFuzzyTokens is the maximum number of trailing tokens that are compared
+// against the legal forms in fuzzy mode.
+const maxFuzzyTokens = 4
+
+// ocrConfusions are characters and character sequences that are commonly
+// confused by OCR. Replacing one with the other only counts as half an edit.
+// The pairs apply in both directions and refer to cleaned, i.e. lower case,
+// texts.
+var ocrConfusions = [][2][]rune{
+	{[]rune("0"), []rune("o")},
+	{[]rune("1"), []rune("l")},
+	{[]rune("1"), []rune("i")},
+	{[]rune("l"), []rune("i")},
+	{[]rune("5"), []rune("s")},
+	{[]rune("8"), []rune("b")},
+	{[]rune("rn"), []rune("m")},
+	{[]rune("vv"), []rune("w")},
+	{[]rune("cl"), []rune("d")},
+	{[]rune("ii"), []rune("h")},
+	{[]rune("li"), []rune("h")},
+	{[]rune("il"), []rune("h")},
+	{[]rune("ll"), []rune("h")},
+}
+
+// StripFuzzy strips the legal form from the end of the full company name like
+// Strip, but also accepts misspelled or OCR-damaged legal forms, e.g. "GmbII",
+// "Limted" or "Ltcl".
+//
+// A legal form is accepted if its cleaned text is within maxDistance edits of
+// a legal form. Confusions that are common for OCR, e.g. "0" and "o", "1" and
+// "l" or "rn" and "m", only count as half an edit. As ordinary words are easily
+// within a few edits of a legal form, e.g. "Compass" of "Company" or "Inn" of
+// "Inc", other edits are restricted:
+//
+//   - legal forms with at least seven characters accept a single missing,
+//     replaced or transposed character, e.g. "Limted", but no additional
+//     character, e.g. "Foundation" is not accepted as "Fondation",
+//   - shorter legal forms only accept OCR confusions and their first character
+//     must be correct,
+//   - legal forms with a medium or high ambiguity (see AmbiguityOf) are never
+//     matched fuzzily.
+//
+// Exact matches are always preferred.
+//
+// If several legal forms are equally close, then the longer one is preferred,
+// followed by the one that is used in more countries.
+//
+// As the trailing tokens are compared against many legal forms, StripFuzzy is
+// considerably slower than Strip for names without an exact match. Use a
+// Matcher when stripping many names, as it prepares the legal forms only once.
+//
+// Besides the name and the legal form as it was written, the key of the legal
+// form it was corrected to is returned, e.g. "gmbh" for "GmbII". If no legal
+// form was found, then both are empty.
+func (f LegalForms) StripFuzzy(fullName string, maxDistance int) (string, string, string) {
+	return stripFuzzy(f, func(firsts []rune) fuzzyKeys {
+		return newFuzzyKeys(maps.Keys(f), defaultCountries, firsts)
+	}, fullName, maxDistance)
+}
+
+// StripFuzzy strips the misspelled or OCR-damaged legal form from the end of
+// the full company name like LegalForms.StripFuzzy.
+func (m *Matcher) StripFuzzy(fullName string, maxDistance int) (string, string, string) {
+	name, legalForm, key := stripFuzzy(m, func([]rune) fuzzyKeys {
+		return m.fuzzy()
+	}, fullName, maxDistance)
+	return m.trim(name), legalForm, key
+}
+
+// minFuzzyRunes is the minimum length of a legal form to be matched fuzzily.
+const minFuzzyRunes = 3
+
+// minFuzzyEditRunes is the minimum length of a legal form to accept an edit
+// other than OCR confusions.
+const minFuzzyEditRunes = 7
+
+// fuzzyKeys are the keys of the legal forms grouped by their first character.
+// Keys that are too short or too ambiguous to be matched fuzzily are omitted.
+type fuzzyKeys map[rune][]fuzzyKey
+
+type fuzzyKey struct {
+	key   string
+	runes []rune
+	// countries is the number of countries the legal form is used in.
+	countries int
+}
+
+// newFuzzyKeys groups the keys by their first character. If firsts is not nil,
+// then only keys starting with one of its characters are included.
+func newFuzzyKeys(keys iter.Seq[string], countries countryIndex, firsts []rune) fuzzyKeys {
+	k := fuzzyKeys{}
+	for key := range keys {
+		first, _ := utf8.DecodeRuneInString(key)
+		if firsts != nil && !slices.Contains(firsts, first) ||
+			utf8.RuneCountInString(key) < minFuzzyRunes || ambiguity(key) > AmbiguityLow {
+			continue
+		}
+		runes := []rune(key)
+		k[runes[0]] = append(k[runes[0]], fuzzyKey{key: key, runes: runes, countries: len(countries.keys[key])})
+	}
+	return k
+}
+
+type fuzzyCandidate struct {
+	start     int
+	key       string
+	distance  int
+	countries int
+}
+
+// better checks if the candidate should be preferred over the other one.
+func (c fuzzyCandidate) better(other fuzzyCandidate) bool {
+	if c.distance != other.distance {
+		return c.distance < other.distance
+	}
+	if length, otherLength := utf8.RuneCountInString(c.key), utf8.RuneCountInString(other.key); length != otherLength {
+		return length > otherLength
+	}
+	if c.countries != other.countries {
+		return c.countries > other.countries
+	}
+	if c.start != other.start {
+		return c.start < other.start
+	}
+	return c.key < other.key
+}
+
+// stripFuzzy strips the exact legal form if there is one and otherwise the
+// closest one. The keys are only requested if there is no exact match and
+// only need to contain those starting with one of the passed characters.
+func stripFuzzy(idx index, keys func(firsts []rune) fuzzyKeys, fullName string, maxDistance int) (string, string, string) {
+	tokens := tokenize(idx, fullName)
+	cleanTokens := cleanTokens(tokens)
+	start := suffixStart(idx, cleanTokens, nil)
+	if start == len(tokens) && maxDistance > 0 {
+		if best, ok := fuzzySuffix(keys(fuzzyFirstRunes(cleanTokens)), cleanTokens, maxDistance); ok {
+			return join(tokens[:best.start], fullName), join(tokens[best.start:], fullName), best.key
+		}
+	}
+	return join(tokens[:start], fullName), join(tokens[start:], fullName), strings.Join(cleanTokens[start:], "")
+}
+
+// The costs of edits in the distances. Each edit costs more than the OCR
+// confusions that can be accepted for any maxDistance, so the number of edits
+// and confusions can be derived from the distance. Characters that are not
+// part of the legal form cost more than any accepted distance.
+const (
+	editCost      = 64
+	confusionCost = 1
+	extraCost     = 2 * editCost
+)
+
+// halfEdits converts the distance into the number of half edits, i.e. each
+// confusion counts as one and every other edit as two.
+func halfEdits(distance int) int {
+	return distance/editCost*2 + distance%editCost
+}
+
+// fuzzySuffix finds the legal form with the lowest distance to the trailing
+// tokens. The first token is never considered to be part of the legal form.
+func fuzzySuffix(keys fuzzyKeys, cleanTokens []string, maxDistance int) (fuzzyCandidate, bool) {
+	best := fuzzyCandidate{}
+	var d []int
+	for start := len(cleanTokens) - 1; start > 0 && start >= len(cleanTokens)-maxFuzzyTokens; start-- {
+		s := []rune(strings.Join(cleanTokens[start:], ""))
+		for _, first := range firstRunes(s) {
+			for _, k := range keys[first] {
+				// Short legal forms only accept confusions and must start with
+				// the same character. Long legal forms accept a single edit.
+				confusions, edits := min(2*maxDistance, editCost-1), 0
+				if len(k.runes) < minFuzzyEditRunes {
+					if first != s[0] {
+						continue
+					}
+				} else if maxDistance > 0 {
+					edits = 1
+				}
+				limit := confusions*confusionCost + edits*editCost
+				// Every additional or missing character is either part of a
+				// confusion or a missing character of the legal form.
+				if diff := len(s) - len(k.runes); diff > confusions || -diff > confusions+edits {
+					continue
+				}
+				var distance int
+				distance, d = ocrDistance(s, k.runes, limit, d)
+				if distance > limit || halfEdits(distance) > 2*maxDistance {
+					continue
+				}
+				candidate := fuzzyCandidate{start: start, key: k.key, distance: distance, countries: k.countries}
+				if best.key == "" || candidate.better(best) {
+					best = candidate
+				}
+			}
+		}
+	}
+	return best, best.key != ""
+}
+
+// fuzzyFirstRunes returns the characters the legal forms that fuzzySuffix
+// compares with the trailing tokens may start with.
+func fuzzyFirstRunes(cleanTokens []string) []rune {
+	runes := []rune{}
+	for start := len(cleanTokens) - 1; start > 0 && start >= len(cleanTokens)-maxFuzzyTokens; start-- {
+		for _, r := range firstRunes([]rune(strings.Join(cleanTokens[start:], ""))) {
+			if !slices.Contains(runes, r) {
+				runes = append(runes, r)
+			}
+		}
+	}
+	return runes
+}
+
+// firstRunes returns the characters a legal form may start with to be
+// compared with s, i.e. the first character of s and its OCR confusions.
+func firstRunes(s []rune) []rune {
+	if len(s) == 0 {
+		return nil
+	}
+	runes := []rune{s[0]}
+	for _, c := range ocrConfusions {
+		for _, pair := range [][2][]rune{{c[0], c[1]}, {c[1], c[0]}} {
+			if hasRunePrefix(s, pair[0]) && !slices.Contains(runes, pair[1][0]) {
+				runes = append(runes, pair[1][0])
+			}
+		}
+	}
+	return runes
+}
+
+// ocrDistance returns the weighted Damerau-Levenshtein distance between s and t
+// in which OCR confusions are cheaper than other edits. Distances above limit
+// are reported as limit+1. The matrix d is reused if it is large enough and
+// returned for further calls.
+func ocrDistance(s, t []rune, limit int, d []int) (int, []int) {
+	cols := len(t) + 1
+	size := (len(s) + 1) * cols
+	if cap(d) < size {
+		d = make([]int, size)
+	}
+	d = d[:size]
+	for j := range cols {
+		d[j] = j * editCost
+	}
+	for i := 1; i <= len(s); i++ {
+		row := d[i*cols : (i+1)*cols]
+		row[0] = i * extraCost
+		rowMin := row[0]
+		for j := 1; j < cols; j++ {
+			row[j] = editDistance(d, cols, s, t, i, j)
+			rowMin = min(rowMin, row[j])
+		}
+		// Each row only depends on the two previous rows, hence the distance
+		// cannot get below the limit anymore.
+		if rowMin > limit && i > 1 && slices.Min(d[(i-1)*cols:i*cols]) > limit {
+			return limit + 1, d
+		}
+	}
+	return min(d[size-1], limit+1), d
+}
+
+// editDistance calculates the distance for s[:i] and t[:j] from the already
+// calculated distances.
+func editDistance(d []int, cols int, s, t []rune, i, j int) int {
+	a, b := s[i-1], t[j-1]
+	// None of the confusions end with the same character on both sides, hence
+	// equal characters are always best matched with each other.
+	if a == b {
+		return d[(i-1)*cols+j-1]
+	}
+	distance := min(d[(i-1)*cols+j]+extraCost, min(d[i*cols+j-1], d[(i-1)*cols+j-1])+editCost)
+	if i > 1 && j > 1 && a == t[j-2] && s[i-2] == b {
+		distance = min(distance, d[(i-2)*cols+j-2]+editCost)
+	}
+	for _, c := range ocrConfusions {
+		x, y := c[0], c[1]
+		if a == x[len(x)-1] && b == y[len(y)-1] && hasRuneSuffix(s[:i], x) && hasRuneSuffix(t[:j], y) {
+			distance = min(distance, d[(i-len(x))*cols+j-len(y)]+confusionCost)
+		}
+		if a == y[len(y)-1] && b == x[len(x)-1] && hasRuneSuffix(s[:i], y) && hasRuneSuffix(t[:j], x) {
+			distance = min(distance, d[(i-len(y))*cols+j-len(x)]+confusionCost)
+		}
+	}
+	return distance
+}
+
+func hasRunePrefix(s, prefix []rune) bool {
+	return len(s) >= len(prefix) && slices.Equal(s[:len(prefix)], prefix)
+}
+
+func hasRuneSuffix(s, suffix []rune) bool {
+	return len(s) >= len(suffix) && slices.Equal(s[len(s)-len(suffix):], suffix)
+}
@@ -0,0 +1,116 @@
+package legalform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestStripFuzzy(t *testing.T) {
+	cases := []struct {
+		fullName          string
+		maxDistance       int
+		expectedName      string
+		expectedLegalForm string
+		expectedKey       string
+	}{
+		{"Example GmbH", 2, "Example", "GmbH", "gmbh"},
+		{"Example Gmbh.", 2, "Example", "Gmbh.", "gmbh"},
+		{"Example GmbII", 2, "Example", "GmbII", "gmbh"},
+		{"Example Limted", 2, "Example", "Limted", "limited"},
+		{"Example Ltcl", 2, "Example", "Ltcl", "ltd"},
+		{"Example 1td", 2, "Example 1td", "", ""},
+		{"Example Lid", 2, "Example Lid", "", ""},
+		{"Example Co. Ltcl.", 2, "Example", "Co. Ltcl.", "coltd"},
+		{"Example Limitedd", 2, "Example Limitedd", "", ""},
+		{"Example Limtd", 2, "Example Limtd", "", ""},
+		{"Example Gmbrn", 2, "Example", "Gmbrn", "gmbm"},
+		{"Example Lirnited", 1, "Example", "Lirnited", "limited"},
+		{"Example Limted", 0, "Example Limted", "", ""},
+		{"Example Lmxtd", 1, "Example Lmxtd", "", ""},
+		{"Example Ab", 2, "Example", "Ab", "ab"},
+		{"Example Ax", 2, "Example Ax", "", ""},
+		{"Limted", 2, "Limted", "", ""},
+		{"", 2, "", "", ""},
+	}
+
+	forms := legalform.NewLegalForms("GmbH", "GmbM", "Limited", "Ltd", "Co. Ltd.", "AB", "AG")
+	matcher := legalform.NewMatcher(forms)
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			name, legalForm, key := forms.StripFuzzy(c.fullName, c.maxDistance)
+			assert.Equal(t, c.expectedName, name)
+			assert.Equal(t, c.expectedLegalForm, legalForm)
+			assert.Equal(t, c.expectedKey, key)
+
+			name, legalForm, key = matcher.StripFuzzy(c.fullName, c.maxDistance)
+			assert.Equal(t, c.expectedName, name)
+			assert.Equal(t, c.expectedLegalForm, legalForm)
+			assert.Equal(t, c.expectedKey, key)
+		})
+	}
+}
+
+func TestStripFuzzyDefault(t *testing.T) {
+	cases := []struct {
+		fullName          string
+		expectedName      string
+		expectedLegalForm string
+		expectedKey       string
+	}{
+		{"Example GmbII", "Example", "GmbII", "gmbh"},
+		{"Example Limted", "Example", "Limted", "limited"},
+		{"Example Ltcl", "Example", "Ltcl", "ltd"},
+		{"Example Lirnited", "Example", "Lirnited", "limited"},
+		{"Example Lid", "Example Lid", "", ""},
+		{"Example Limitecl", "Example", "Limitecl", "limited"},
+		{"Example Gmbh.", "Example", "Gmbh.", "gmbh"},
+		{"Example Bakery", "Example Bakery", "", ""},
+		{"Müller Bau", "Müller Bau", "", ""},
+		{"Holiday Inn", "Holiday Inn", "", ""},
+		{"Acme Pro", "Acme Pro", "", ""},
+		{"Acme App", "Acme App", "", ""},
+		{"Acme Car", "Acme Car", "", ""},
+		{"Acme Toy", "Acme Toy", "", ""},
+		{"Acme Cat", "Acme Cat", "", ""},
+		{"Acme Tea", "Acme Tea", "", ""},
+		{"Acme Compass", "Acme Compass", "", ""},
+		{"Acme Limits", "Acme Limits", "", ""},
+		{"Sky Limit", "Sky Limit", "", ""},
+		{"Acme Foundation", "Acme Foundation", "", ""},
+		{"Acme Fundacion", "Acme Fundacion", "", ""},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			name, legalForm, key := legalform.DefaultMatcher().StripFuzzy(c.fullName, 2)
+			assert.Equal(t, c.expectedName, name)
+			assert.Equal(t, c.expectedLegalForm, legalForm)
+			assert.Equal(t, c.expectedKey, key)
+
+			name, legalForm, key = legalform.Default.StripFuzzy(c.fullName, 2)
+			assert.Equal(t, c.expectedName, name)
+			assert.Equal(t, c.expectedLegalForm, legalForm)
+			assert.Equal(t, c.expectedKey, key)
+		})
+	}
+}
+
+func BenchmarkStripFuzzyExact(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		legalform.Default.StripFuzzy("Example GmbH", 2)
+	}
+}
+
+func BenchmarkStripFuzzy(b *testing.B) {
+	m := legalform.DefaultMatcher()
+	m.StripFuzzy("Example Limted", 2)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		m.StripFuzzy("Example Holding Internationale Limted", 2)
+	}
+}
@@ -0,0 +1,7 @@
+package legalform
+
+// Download the current ELF code list CSV from GLEIF to elf-code-list.csv before
+// running go generate. The legal forms and aliases of the list are generated
+// into legalforms_elf.go and merged into Default and DefaultAliases. Without
+// the list, the generation is skipped.
+//go:generate go run ./cmd/legalform-elfgen -in elf-code-list.csv -skip-missing -forms elfLegalForms -aliases elfAliases -out legalforms_elf.go
@@ -0,0 +1,57 @@
+package legalform
+
+import "strings"
+
+// index provides the lookup of cleaned legal forms for the strip algorithms.
+type index interface {
+	has(key string) bool
+	// suffixes appends the index of every token at which a legal form starts
+	// that ends with the last token to starts, starting with the shortest
+	// legal form.
+	suffixes(cleanTokens []string, starts []int) []int
+	// prefixes appends the index after every token at which a legal form ends
+	// that starts with the first token to ends, starting with the shortest
+	// legal form.
+	prefixes(cleanTokens []string, ends []int) []int
+}
+
+// lookup is the minimal lookup of cleaned legal forms from which the
+// remaining methods of index can be derived.
+type lookup interface {
+	has(key string) bool
+}
+
+func (f LegalForms) has(key string) bool {
+	_, ok := f[key]
+	return ok
+}
+
+func (f LegalForms) suffixes(cleanTokens []string, starts []int) []int {
+	return lookupSuffixes(f, cleanTokens, starts)
+}
+
+func (f LegalForms) prefixes(cleanTokens []string, ends []int) []int {
+	return lookupPrefixes(f, cleanTokens, ends)
+}
+
+// lookupSuffixes implements index.suffixes by looking up every possible
+// suffix individually.
+func lookupSuffixes(l lookup, cleanTokens []string, starts []int) []int {
+	for i := len(cleanTokens) - 1; i >= 0; i-- {
+		if l.has(strings.Join(cleanTokens[i:], "")) {
+			starts = append(starts, i)
+		}
+	}
+	return starts
+}
+
+// lookupPrefixes implements index.prefixes by looking up every possible
+// prefix individually.
+func lookupPrefixes(l lookup, cleanTokens []string, ends []int) []int {
+	for i := 1; i <= len(cleanTokens); i++ {
+		if l.has(strings.Join(cleanTokens[:i], "")) {
+			ends = append(ends, i)
+		}
+	}
+	return ends
+}
@@ -1,3 +1,8 @@
+// Package legalform removes or extracts the legal form of a company name.
+//
+// Resolving legal forms to ISO 20275 entity legal form (ELF) codes requires
+// the ELF code list CSV published by GLEIF, which is not included. Load it
+// using ReadELFCodes.
 package legalform
 
 // LegalForms represents a data structure against which to check the legal
@@ -7,7 +12,13 @@ package legalform
 type LegalForms map[string]struct{}
 
 // Default represents the list of all supported legal forms.
-var Default = LegalForms{
+//
+// It contains the maintained legal forms as well as those generated from the
+// ELF code list, see generate.go.
+var Default = builtinLegalForms.Merge(elfLegalForms)
+
+// builtinLegalForms are the maintained legal forms of Default.
+var builtinLegalForms = LegalForms{
 	"유":                                   struct{}{},
 	"a":                                   struct{}{},
 	"aa":                                  struct{}{},
@@ -238,6 +249,7 @@ var Default = LegalForms{
 	"entpubfederale":                      struct{}{},
 	"eofg":                                struct{}{},
 	"eog":                                 struct{}{},
+	"eoos":                                struct{}{},
 	"ep":                                  struct{}{},
 	"epe":                                 struct{}{},
 	"epp":                                 struct{}{},
@@ -248,6 +260,7 @@ var Default = LegalForms{
 	"etey":                                struct{}{},
 	"etspubli":                            struct{}{},
 	"eu":                                  struct{}{},
+	"eurl":                                struct{}{},
 	"europzoskuphospzaujm":                struct{}{},
 	"europzoskupuzemspol":                 struct{}{},
 	"ev":                                  struct{}{},
@@ -406,6 +419,7 @@ var Default = LegalForms{
 	"lbg":                                 struct{}{},
 	"lc":                                  struct{}{},
 	"lca":                                 struct{}{},
+	"lda":                                 struct{}{},
 	"ldc":                                 struct{}{},
 	"ldclimited":                          struct{}{},
 	"ldcltd":                              struct{}{},
@@ -649,6 +663,7 @@ var Default = LegalForms{
 	"sasofomenr":                          struct{}{},
 	"sasofomer":                           struct{}{},
 	"saspj":                               struct{}{},
+	"sasu":                                struct{}{},
 	"sat":                                 struct{}{},
 	"sau":                                 struct{}{},
 	"sb":                                  struct{}{},
@@ -802,6 +817,7 @@ var Default = LegalForms{
 	"srlp":                                struct{}{},
 	"srls":                                struct{}{},
 	"srlsemplificata":                     struct{}{},
+	"sro":                                 struct{}{},
 	"ss":                                  struct{}{},
 	"ssb":                                 struct{}{},
 	"stathosporgriadokr":                  struct{}{},
@@ -891,7 +907,6 @@ var Default = LegalForms{
 	"zvggugch":                            struct{}{},
 	"zvgpgbh":                             struct{}{},
 	"zweighr":                             struct{}{},
-	"εοος":                                struct{}{},
 	"аат":                                 struct{}{},
 	"ад":                                  struct{}{},
 	"адсиц":                               struct{}{},
@@ -994,7 +1009,7 @@ var Default = LegalForms{
 	"cooperativeassociation":                                struct{}{},
 	"associacao":                                            struct{}{},
 	"eteriaperiorismeniseuthinis":                           struct{}{},
-	"εvropaikiεtaireia":                                     struct{}{},
+	"evropaikietaireia":                                     struct{}{},
 	"sociedaddeproduccionruralderesponsabilidadlimitadadecapitalvariable": struct{}{},
 	"societearesponsabilitelimiteesarl":                                   struct{}{},
 	"naamlozevennootschap":                                                struct{}{},
@@ -1005,7 +1020,7 @@ var Default = LegalForms{
 	"gesellschaftmitbeschrankterhaftung":                                  struct{}{},
 	"saatio":                                                              struct{}{},
 	"partnerschaftsgesellschaft":                                          struct{}{},
-	"europæiskokonomiskfirmagruppe":                                       struct{}{},
+	"europaiskokonomiskfirmagruppe":                                       struct{}{},
 	"sociedadderesponsabilidadlimitadaosociedadlimitada":                  struct{}{},
 	"fundacao":                                     struct{}{},
 	"specialeconomiczonecompany":                   struct{}{},
@@ -1111,7 +1126,7 @@ var Default = LegalForms{
 	"kollektifsirket":                              struct{}{},
 	"et":                                           struct{}{},
 	"eingetragenekauffrau":                         struct{}{},
-	"europæiskandelsselskab":                       struct{}{},
+	"europaiskandelsselskab":                       struct{}{},
 	"kommanditselskab":                             struct{}{},
 	"sociedadporaccionessimplificada":              struct{}{},
 	"cuideachtaghniomhaiochtaainmnithe":            struct{}{},
@@ -1141,7 +1156,7 @@ var Default = LegalForms{
 	"europeiskokonomiskforetaksgruppe":                   struct{}{},
 	"sociedadderesponsabilidadlimitadadecapitalvariable": struct{}{},
 	"beslotenvennootschapmetbeperkteaansprakelijkheid":   struct{}{},
-	"selskabmedbegrænsetansvar":                          struct{}{},
+	"selskabmedbegransetansvar":                          struct{}{},
 	"sociedadderesponsabilidadlimitadamicroindustrial":   struct{}{},
 	"publicunlimitedcompany":                             struct{}{},
 	"publiccompany":                                      struct{}{},
@@ -1149,7 +1164,7 @@ var Default = LegalForms{
 	"fondcommundeplacement":                              struct{}{},
 	"societedinvestissementacapitalfixe":                 struct{}{},
 	"kommanditgesellschaftaufaktien":                     struct{}{},
-	"foreningmedbegrænsetansvar":                         struct{}{},
+	"foreningmedbegransetansvar":                         struct{}{},
 	"stockcorporation":                                   struct{}{},
 	"kt":                                                 struct{}{},
 	"sociedadderesponsabilidadlimitadalaboral":           struct{}{},
@@ -1170,7 +1185,7 @@ var Default = LegalForms{
 	"komanditnodruzhestvosaktsii":                            struct{}{},
 	"societateinnumecolectiv":                                struct{}{},
 	"fondodeinversionenactivosdelmercadomonetario":           struct{}{},
-	"europæiskselskab":                                       struct{}{},
+	"europaiskselskab":                                       struct{}{},
 	"mutualbenefitenterprise":                                struct{}{},
 	"societaconsortilearesponsabilitalimitata":               struct{}{},
 	"privatecompanylimitedbyshares":                          struct{}{},
@@ -1272,7 +1287,7 @@ var Default = LegalForms{
 	"agrupacionesfinancieras":                                            struct{}{},
 	"komanditsirket":                                                     struct{}{},
 	"europeiskekonomiskintresseguppering":                                struct{}{},
-	"iværksætterselskab":                                                 struct{}{},
+	"ivarksatterselskab":                                                 struct{}{},
 	"korlatoltfelelossegutarsasag":                                       struct{}{},
 	"sociedadcooperativaderesponsabilidadlimitadadecapitalvariable":      struct{}{},
 	"societateincomanditapeactiuni":                                      struct{}{},
@@ -1311,7 +1326,7 @@ var Default = LegalForms{
 	"sociedadcivil":                                struct{}{},
 	"dac":                                          struct{}{},
 	"sociedadnacionaldecredito":                    struct{}{},
-	"andelsselskabmedbegrænsetansvar":              struct{}{},
+	"andelsselskabmedbegransetansvar":              struct{}{},
 	"ideellforening":                               struct{}{},
 	"agrupaciondeintereseconomico":                 struct{}{},
 	"societascooperativaeuropaea":                  struct{}{},
@@ -1476,4 +1491,17 @@ var Default = LegalForms{
 	"fze":   struct{}{},
 	"fzc":   struct{}{},
 	"prjsc": struct{}{},
+
+	// KR
+	"주식회사":   struct{}{},
+	"유한회사":   struct{}{},
+	"유한책임회사": struct{}{},
+	"합자회사":   struct{}{},
+	"합명회사":   struct{}{},
+
+	// CN
+	"有限责任公司": struct{}{},
+	"有限責任公司": struct{}{},
+	"股份有限公司": struct{}{},
+	"股份公司":   struct{}{},
 }
@@ -0,0 +1,113 @@
+package legalform
+
+import (
+	"iter"
+	"strings"
+	"unicode/utf8"
+)
+
+// Match represents the result of parsing a full company name.
+//
+// All spans refer to the original input, i.e. white spaces within the name or
+// the legal form are preserved.
+type Match struct {
+	// Input is the full company name that was parsed.
+	Input string
+	// Name is the plain company name without the legal form.
+	Name Span
+	// LegalForm is the legal form as it was written in the input.
+	LegalForm Span
+	// Remainder is everything that followed after the legal form.
+	Remainder Span
+	// Key is the cleaned lookup key of the legal form that matched, e.g.
+	// "gmbhcokg" for "GmbH & Co. KG".
+	Key string
+}
+
+// Found returns true if a legal form was found.
+func (m Match) Found() bool {
+	return m.Key != ""
+}
+
+// Span describes the position of a part of the full company name.
+//
+// Start and End are byte offsets, RuneStart and RuneEnd are rune offsets. Both
+// follow the usual slice semantics, i.e. the end is exclusive. Empty spans
+// still point to the position where the part would have been.
+//
+// Tokens is the number of tokens that were consumed by the part.
+type Span struct {
+	Text      string
+	Start     int
+	End       int
+	RuneStart int
+	RuneEnd   int
+	Tokens    int
+}
+
+// Parse searches the legal form anywhere in the full company name like
+// StripMiddle, but returns the exact positions of the name, the legal form
+// and the remainder within the full company name.
+func (f LegalForms) Parse(fullName string) Match {
+	return parse(f, fullName)
+}
+
+// Candidates returns every possible interpretation of the legal form within
+// the full company name.
+//
+// The candidates are ordered by their position, starting with the legal forms
+// closest to the end, and by their length, starting with the longest. Hence
+// the first candidate is always identical to the result of Parse. If no legal
+// form was found, then the sequence is empty.
+func (f LegalForms) Candidates(fullName string) iter.Seq[Match] {
+	return candidates(f, fullName)
+}
+
+func parse(idx index, fullName string) Match {
+	tokens := tokenize(idx, fullName)
+	cleanTokens := cleanTokens(tokens)
+	start, end := findMiddle(idx, tokens, cleanTokens, nil)
+	return newMatch(fullName, tokens, cleanTokens, start, end)
+}
+
+func candidates(idx index, fullName string) iter.Seq[Match] {
+	return func(yield func(Match) bool) {
+		tokens := tokenize(idx, fullName)
+		cleanTokens := cleanTokens(tokens)
+		ranges(idx, tokens, cleanTokens, nil, func(start, end int) bool {
+			return yield(newMatch(fullName, tokens, cleanTokens, start, end))
+		})
+	}
+}
+
+// newMatch creates the match for a legal form within the given token range.
+func newMatch(fullName string, tokens []token, cleanTokens []string, start, end int) Match {
+	name := newSpan(fullName, tokens[:start], 0)
+	legalForm := newSpan(fullName, tokens[start:end], name.End)
+	return Match{
+		Input:     fullName,
+		Name:      name,
+		LegalForm: legalForm,
+		Remainder: newSpan(fullName, tokens[end:], legalForm.End),
+		Key:       strings.Join(cleanTokens[start:end], ""),
+	}
+}
+
+// newSpan creates the span that covers all the tokens. If there are no
+// tokens, then an empty span at the given byte offset is returned.
+func newSpan(input string, tokens []token, offset int) Span {
+	start, end := offset, offset
+	if len(tokens) > 0 {
+		start = tokens[0].start
+		end = tokens[len(tokens)-1].end
+	}
+	runeStart := utf8.RuneCountInString(input[:start])
+	return Span{
+		Text:      input[start:end],
+		Start:     start,
+		End:       end,
+		RuneStart: runeStart,
+		RuneEnd:   runeStart + utf8.RuneCountInString(input[start:end]),
+		Tokens:    len(tokens),
+	}
+}
@@ -0,0 +1,136 @@
+package legalform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected legalform.Match
+	}{
+		{
+			input: "Example  GmbH &  Co. KG",
+			expected: legalform.Match{
+				Name:      legalform.Span{Text: "Example", Start: 0, End: 7, RuneStart: 0, RuneEnd: 7, Tokens: 1},
+				LegalForm: legalform.Span{Text: "GmbH &  Co. KG", Start: 9, End: 23, RuneStart: 9, RuneEnd: 23, Tokens: 4},
+				Remainder: legalform.Span{Text: "", Start: 23, End: 23, RuneStart: 23, RuneEnd: 23},
+				Key:       "gmbhcokg",
+			},
+		},
+		{
+			input: " Spółka  Example GmbH (Foobar) ",
+			expected: legalform.Match{
+				Name:      legalform.Span{Text: "Spółka  Example", Start: 1, End: 18, RuneStart: 1, RuneEnd: 16, Tokens: 2},
+				LegalForm: legalform.Span{Text: "GmbH", Start: 19, End: 23, RuneStart: 17, RuneEnd: 21, Tokens: 1},
+				Remainder: legalform.Span{Text: "(Foobar)", Start: 24, End: 32, RuneStart: 22, RuneEnd: 30, Tokens: 1},
+				Key:       "gmbh",
+			},
+		},
+		{
+			input: "トヨタ株式会社",
+			expected: legalform.Match{
+				Name:      legalform.Span{Text: "トヨタ", Start: 0, End: 9, RuneStart: 0, RuneEnd: 3, Tokens: 1},
+				LegalForm: legalform.Span{Text: "株式会社", Start: 9, End: 21, RuneStart: 3, RuneEnd: 7, Tokens: 1},
+				Remainder: legalform.Span{Text: "", Start: 21, End: 21, RuneStart: 7, RuneEnd: 7},
+				Key:       "株式会社",
+			},
+		},
+		{
+			input: "Example Foobar",
+			expected: legalform.Match{
+				Name:      legalform.Span{Text: "Example Foobar", Start: 0, End: 14, RuneStart: 0, RuneEnd: 14, Tokens: 2},
+				LegalForm: legalform.Span{Text: "", Start: 14, End: 14, RuneStart: 14, RuneEnd: 14},
+				Remainder: legalform.Span{Text: "", Start: 14, End: 14, RuneStart: 14, RuneEnd: 14},
+			},
+		},
+		{
+			input:    "",
+			expected: legalform.Match{},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			c.expected.Input = c.input
+			actual := legalform.Default.Parse(c.input)
+			assert.Equal(t, c.expected, actual)
+			assert.Equal(t, c.expected.Key != "", actual.Found())
+			assert.Equal(t, actual.LegalForm.Text, c.input[actual.LegalForm.Start:actual.LegalForm.End])
+			assert.Equal(t, actual.LegalForm.Text, string([]rune(c.input)[actual.LegalForm.RuneStart:actual.LegalForm.RuneEnd]))
+		})
+	}
+}
+
+func TestParseMatchesStripMiddle(t *testing.T) {
+	inputs := []string{
+		"Example LLC",
+		"Example GmbH & Co. KG Foobar",
+		"Example LLC GmbH & Co. KG Some Street Name No 1",
+		"Foo A Example",
+		"LLC Example",
+	}
+
+	for i, input := range inputs {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			company, legalForm, other := legalform.Default.StripMiddle(input)
+			actual := legalform.Default.Parse(input)
+			assert.Equal(t, company, actual.Name.Text)
+			assert.Equal(t, legalForm, actual.LegalForm.Text)
+			assert.Equal(t, other, actual.Remainder.Text)
+		})
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected [][2]string
+	}{
+		{
+			input: "Example Trust Company Ltd",
+			expected: [][2]string{
+				{"Example Trust", "Company Ltd"},
+				{"Example Trust Company", "Ltd"},
+				{"Example", "Trust Company"},
+				{"Example Trust", "Company"},
+				{"Example", "Trust"},
+			},
+		},
+		{
+			input: "Foo AS Co",
+			expected: [][2]string{
+				{"Foo AS", "Co"},
+				{"Foo", "AS"},
+			},
+		},
+		{
+			input:    "Example",
+			expected: nil,
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			var actual [][2]string
+			for m := range legalform.Default.Candidates(c.input) {
+				assert.Equal(t, m.LegalForm.Text, c.input[m.LegalForm.Start:m.LegalForm.End])
+				actual = append(actual, [2]string{m.Name.Text, m.LegalForm.Text})
+			}
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func TestCandidatesStartsWithParse(t *testing.T) {
+	input := "Example LLC GmbH & Co. KG Some Street Name No 1"
+	for m := range legalform.Default.Candidates(input) {
+		assert.Equal(t, legalform.Default.Parse(input), m)
+		assert.Equal(t, 4, m.LegalForm.Tokens)
+		break
+	}
+}
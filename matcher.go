@@ -0,0 +1,369 @@
+package legalform
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Matcher is a precompiled version of LegalForms together with its Aliases.
+//
+// It gives the same results as the LegalForms it was created from, but instead
+// of looking up every possible combination of tokens individually, it walks a
+// trie of the cleaned legal forms token by token. This makes it considerably
+// faster for long company names, especially when using StripMiddle.
+//
+// Strip, StripMiddle and StripPrefix reuse their buffers between calls. They
+// allocate the cleaned copy of the name and, only if the name contains white
+// spaces other than single blanks, the returned strings.
+//
+// A Matcher cannot be modified after its creation and is safe for concurrent
+// use. Changes to the LegalForms or Aliases it was created from are not
+// reflected. Use AtomicMatcher to replace a Matcher at runtime.
+type Matcher struct {
+	keys      []string
+	forward   trie
+	reverse   trie
+	aliases   Aliases
+	countries countryIndex
+	splitCase bool
+	trimNames bool
+	guard     bool
+	// fuzzy returns the keys for StripFuzzy, which are created on first use.
+	fuzzy func() fuzzyKeys
+}
+
+// MatcherOption configures a Matcher during its creation.
+type MatcherOption func(*Matcher)
+
+// WithAliases uses the provided aliases instead of DefaultAliases.
+//
+// The aliases are used by Alias and to decide which legal forms are used in
+// which country for StripForCountry and which legal forms are written in
+// front of the name for StripPrefix.
+func WithAliases(aliases Aliases) MatcherOption {
+	return func(m *Matcher) {
+		m.aliases = Aliases{}.Merge(aliases)
+	}
+}
+
+// WithCaseSplitting also separates legal forms that are attached to the name
+// by a change from a lower case to an upper case letter, e.g. "ExampleGmbH" or
+// "ExampleLtd".
+//
+// This is disabled by default, because names written in camel case might end
+// with a word that happens to be a legal form.
+func WithCaseSplitting() MatcherOption {
+	return func(m *Matcher) {
+		m.splitCase = true
+	}
+}
+
+// WithNameTrimming applies TrimName to the names that are returned after
+// stripping the legal form, e.g. "Example, Inc." results in "Example" instead
+// of "Example,".
+//
+// Parse and Candidates are not affected, as they describe the exact positions
+// within the full company name.
+func WithNameTrimming() MatcherOption {
+	return func(m *Matcher) {
+		m.trimNames = true
+	}
+}
+
+// NewMatcher creates a new matcher for the legal forms.
+//
+// Unless configured otherwise, the matcher uses a copy of DefaultAliases.
+func NewMatcher(f LegalForms, opts ...MatcherOption) *Matcher {
+	keys := slices.Sorted(maps.Keys(f))
+	m := &Matcher{
+		keys:    keys,
+		forward: newTrie(keys, false),
+		reverse: newTrie(keys, true),
+		aliases: Aliases{}.Merge(DefaultAliases),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.countries = newCountryIndex(m.aliases, countryLegalForms, DefaultRegistry)
+	m.fuzzy = sync.OnceValue(func() fuzzyKeys {
+		return newFuzzyKeys(slices.Values(m.keys), m.countries, nil)
+	})
+	return m
+}
+
+// defaultMatcher creates the matcher returned by DefaultMatcher on first use.
+var defaultMatcher = sync.OnceValue(func() *Matcher {
+	return NewMatcher(Default)
+})
+
+// DefaultMatcher returns the matcher for Default and DefaultAliases.
+//
+// It is created on first use. Later changes to Default or DefaultAliases are
+// not reflected.
+func DefaultMatcher() *Matcher {
+	return defaultMatcher()
+}
+
+// Strip strips the legal form from the end of the full company name like
+// LegalForms.Strip.
+func (m *Matcher) Strip(fullName string) (string, string) {
+	return m.strip(m, "", fullName)
+}
+
+// StripMiddle strips the legal form from anywhere in the full company name
+// like LegalForms.StripMiddle.
+func (m *Matcher) StripMiddle(fullName string) (string, string, string) {
+	name, legalForm, remainder := stripMiddle(m, fullName)
+	return m.trim(name), legalForm, remainder
+}
+
+// StripPrefix strips the legal forms from the beginning and the end of the
+// full company name like LegalForms.StripPrefix.
+func (m *Matcher) StripPrefix(fullName string) (string, string, string) {
+	name, prefix, suffix := stripPrefix(m, m.countries, fullName)
+	return m.trim(name), prefix, suffix
+}
+
+// StripForCountry strips the legal form from the end of the full company name
+// like LegalForms.StripForCountry, but based on the aliases of the matcher.
+func (m *Matcher) StripForCountry(country, fullName string) (string, string) {
+	return m.strip(m.forCountry(country), country, fullName)
+}
+
+// Parse searches the legal form anywhere in the full company name like
+// LegalForms.Parse.
+func (m *Matcher) Parse(fullName string) Match {
+	return parse(m, fullName)
+}
+
+// Candidates returns every possible interpretation of the legal form within
+// the full company name like LegalForms.Candidates.
+func (m *Matcher) Candidates(fullName string) iter.Seq[Match] {
+	return candidates(m, fullName)
+}
+
+// Alias returns the alias for the legal form like Aliases.Find.
+func (m *Matcher) Alias(country, legalForm string) string {
+	return m.aliases.Find(country, legalForm)
+}
+
+// strip strips the legal form from the end of the full company name using the
+// index and applies the ambiguity guard and the trimming of the name if
+// enabled. The country is only used as evidence for the ambiguity guard.
+func (m *Matcher) strip(idx index, country, fullName string) (string, string) {
+	if !m.guard {
+		name, legalForm := strip(idx, fullName)
+		return m.trim(name), legalForm
+	}
+	b := getTokens(idx, fullName)
+	defer b.release()
+	start := guardedSuffixStart(idx, m.countries, elfAliasCountry(country), fullName, b.tokens, b.cleanTokens, b.starts)
+	return m.trim(join(b.tokens[:start], fullName)), join(b.tokens[start:], fullName)
+}
+
+// trim applies TrimName to the name if enabled.
+func (m *Matcher) trim(name string) string {
+	if !m.trimNames {
+		return name
+	}
+	return TrimName(name)
+}
+
+func (m *Matcher) has(key string) bool {
+	return matcherIndex{m: m}.has(key)
+}
+
+func (m *Matcher) suffixes(cleanTokens []string, starts []int) []int {
+	return matcherIndex{m: m}.suffixes(cleanTokens, starts)
+}
+
+func (m *Matcher) prefixes(cleanTokens []string, ends []int) []int {
+	return matcherIndex{m: m}.prefixes(cleanTokens, ends)
+}
+
+func (m *Matcher) splitsCase() bool {
+	return m.splitCase
+}
+
+// forCountry returns the index for the legal forms of the country.
+func (m *Matcher) forCountry(country string) index {
+	country = elfAliasCountry(country)
+	if !m.countries.knows(country) {
+		return m
+	}
+	return matcherIndex{m: m, country: country}
+}
+
+// matcherIndex implements index for a matcher. If a country is set, then only
+// legal forms that are allowed in that country are considered.
+type matcherIndex struct {
+	m       *Matcher
+	country string
+}
+
+func (i matcherIndex) has(key string) bool {
+	n := 0
+	for j := 0; j < len(key); j++ {
+		var ok bool
+		if n, ok = i.m.forward.next(n, key[j]); !ok {
+			return false
+		}
+	}
+	return i.accepts(i.m.forward.nodes[n])
+}
+
+func (i matcherIndex) suffixes(cleanTokens []string, starts []int) []int {
+	n := 0
+	for j := len(cleanTokens) - 1; j >= 0; j-- {
+		t := cleanTokens[j]
+		for k := len(t) - 1; k >= 0; k-- {
+			var ok bool
+			if n, ok = i.m.reverse.next(n, t[k]); !ok {
+				return starts
+			}
+		}
+		if i.accepts(i.m.reverse.nodes[n]) {
+			starts = append(starts, j)
+		}
+	}
+	return starts
+}
+
+func (i matcherIndex) prefixes(cleanTokens []string, ends []int) []int {
+	n := 0
+	for j, t := range cleanTokens {
+		for k := 0; k < len(t); k++ {
+			var ok bool
+			if n, ok = i.m.forward.next(n, t[k]); !ok {
+				return ends
+			}
+		}
+		if i.accepts(i.m.forward.nodes[n]) {
+			ends = append(ends, j+1)
+		}
+	}
+	return ends
+}
+
+func (i matcherIndex) splitsCase() bool {
+	return i.m.splitCase
+}
+
+// accepts checks if the node represents a legal form that is allowed in the
+// country.
+func (i matcherIndex) accepts(node trieNode) bool {
+	if node.key == 0 {
+		return false
+	}
+	return i.country == "" || i.m.countries.allows(i.country, i.m.keys[node.key-1])
+}
+
+// AtomicMatcher holds a Matcher that can be replaced at runtime, e.g. when the
+// legal forms were reloaded. It is safe for concurrent use.
+//
+// The zero value holds no matcher.
+type AtomicMatcher struct {
+	p atomic.Pointer[Matcher]
+}
+
+// NewAtomicMatcher creates a new AtomicMatcher holding m.
+func NewAtomicMatcher(m *Matcher) *AtomicMatcher {
+	a := &AtomicMatcher{}
+	a.Store(m)
+	return a
+}
+
+// Load returns the current matcher.
+func (a *AtomicMatcher) Load() *Matcher {
+	return a.p.Load()
+}
+
+// Store replaces the current matcher with m.
+func (a *AtomicMatcher) Store(m *Matcher) {
+	a.p.Store(m)
+}
+
+// Swap replaces the current matcher with m and returns the previous one.
+func (a *AtomicMatcher) Swap(m *Matcher) *Matcher {
+	return a.p.Swap(m)
+}
+
+// trie is a byte-wise trie of the cleaned legal forms. The root node is
+// always the first node.
+type trie struct {
+	nodes []trieNode
+	edges []trieEdge
+}
+
+// trieNode describes a node of the trie. Its outgoing edges are stored in
+// trie.edges, starting at first and sorted by their byte.
+//
+// If the node completes a legal form, then key is its position within the
+// keys the trie was built from plus one, otherwise it is 0.
+type trieNode struct {
+	first int32
+	count int32
+	key   int32
+}
+
+type trieEdge struct {
+	b    byte
+	node int32
+}
+
+// newTrie builds the trie for the keys. If reversed is true, the bytes of each
+// key are added in reverse order.
+func newTrie(keys []string, reversed bool) trie {
+	type buildNode struct {
+		children map[byte]int
+		key      int
+	}
+	nodes := []buildNode{{children: map[byte]int{}}}
+	for k, key := range keys {
+		n := 0
+		for i := range len(key) {
+			b := key[i]
+			if reversed {
+				b = key[len(key)-1-i]
+			}
+			child, ok := nodes[n].children[b]
+			if !ok {
+				child = len(nodes)
+				nodes[n].children[b] = child
+				nodes = append(nodes, buildNode{children: map[byte]int{}})
+			}
+			n = child
+		}
+		nodes[n].key = k + 1
+	}
+
+	t := trie{nodes: make([]trieNode, len(nodes))}
+	for i, node := range nodes {
+		t.nodes[i] = trieNode{
+			first: int32(len(t.edges)),
+			count: int32(len(node.children)),
+			key:   int32(node.key),
+		}
+		for _, b := range slices.Sorted(maps.Keys(node.children)) {
+			t.edges = append(t.edges, trieEdge{b: b, node: int32(node.children[b])})
+		}
+	}
+	return t
+}
+
+// next returns the node that follows n for the byte b.
+func (t trie) next(n int, b byte) (int, bool) {
+	node := t.nodes[n]
+	edges := t.edges[node.first : node.first+node.count]
+	i, ok := slices.BinarySearchFunc(edges, b, func(e trieEdge, b byte) int {
+		return cmp.Compare(e.b, b)
+	})
+	if !ok {
+		return 0, false
+	}
+	return int(edges[i].node), true
+}
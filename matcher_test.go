@@ -0,0 +1,212 @@
+package legalform_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+var matcherInputs = []string{
+	"",
+	"Example",
+	"Example Inc.",
+	"Example GmbH & Co. KG",
+	"Example  GmbH  &  Co.  KG",
+	"Some Example S. A. de C. V., F. I. en I. D.",
+	"Example LLC GmbH & Co. KG Some Street Name No 1",
+	"Example GmbH Textilien + Angelware",
+	"ООО Ромашка",
+	"PT Example Indonesia Tbk",
+	"SC Example SRL",
+	"トヨタ株式会社",
+	"株式会社トヨタ",
+	"(주)삼성전자",
+	"㈱トヨタ",
+	"Société Générale S.A.",
+	"A & B",
+	"Foo Bar\tLtd.",
+	"Ltd.",
+	"GmbH Example",
+	"Example-GmbH",
+	"Foo-Bar/AG,Inc.",
+	"Example-AG Some-Street GmbH",
+}
+
+func TestMatcherMatchesLegalForms(t *testing.T) {
+	matcher := legalform.NewMatcher(legalform.Default)
+	for _, input := range matcherInputs {
+		t.Run(input, func(t *testing.T) {
+			assertSameResults(t, matcher, legalform.Default, input)
+		})
+	}
+}
+
+func TestMatcherIsNotModified(t *testing.T) {
+	forms := legalform.LegalForms{"gmbh": struct{}{}}
+	matcher := legalform.NewMatcher(forms)
+	forms["ag"] = struct{}{}
+
+	name, legalForm := matcher.Strip("Example AG")
+	assert.Equal(t, "Example AG", name)
+	assert.Equal(t, "", legalForm)
+}
+
+func TestMatcherStripForCountry(t *testing.T) {
+	matcher := legalform.DefaultMatcher()
+	for _, country := range []string{"DE", "us", "NO", "JP", "CN", "XX", ""} {
+		for _, input := range matcherInputs {
+			expectedName, expectedLegalForm := legalform.Default.StripForCountry(country, input)
+			actualName, actualLegalForm := matcher.StripForCountry(country, input)
+			assert.Equal(t, expectedName, actualName, country, input)
+			assert.Equal(t, expectedLegalForm, actualLegalForm, country, input)
+		}
+	}
+}
+
+func TestMatcherWithAliases(t *testing.T) {
+	aliases := legalform.Aliases{
+		"XY": map[string]string{"gesellschaftmitbeschrankterhaftung": "gmbh"},
+	}
+	matcher := legalform.NewMatcher(legalform.Default, legalform.WithAliases(aliases))
+	aliases["XY"]["aktiengesellschaft"] = "ag"
+
+	assert.Equal(t, "gmbh", matcher.Alias("xy", "Gesellschaft mit beschränkter Haftung"))
+	assert.Equal(t, "aktiengesellschaft", matcher.Alias("XY", "Aktiengesellschaft"))
+	assert.Equal(t, "aktiengesellschaft", matcher.Alias("DE", "Aktiengesellschaft"))
+
+	name, legalForm := matcher.StripForCountry("XY", "Example AG")
+	assert.Equal(t, "Example AG", name)
+	assert.Equal(t, "", legalForm)
+	name, legalForm = matcher.StripForCountry("XY", "Example GmbH")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "GmbH", legalForm)
+
+	matcher = legalform.NewMatcher(legalform.Default, legalform.WithAliases(legalform.Aliases{
+		"RU": map[string]string{"ag": "ao"},
+	}))
+	name, prefix, suffix := matcher.StripPrefix("AG Example")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "AG", prefix)
+	assert.Equal(t, "", suffix)
+	name, prefix, _ = legalform.DefaultMatcher().StripPrefix("AG Example")
+	assert.Equal(t, "AG Example", name)
+	assert.Equal(t, "", prefix)
+}
+
+func TestMatcherWithCaseSplitting(t *testing.T) {
+	cases := []struct {
+		input               string
+		expectedCompanyName string
+		expectedLegalForm   string
+	}{
+		{"ExampleGmbH", "Example", "GmbH"},
+		{"ExampleLtd.", "Example", "Ltd."},
+		{"McDonaldsLtd", "McDonalds", "Ltd"},
+		{"ExampleGmbH & Co. KG", "Example", "GmbH & Co. KG"},
+		{"PowerSA", "Power", "SA"},
+		{"ExampleAs", "ExampleAs", ""},
+		{"MacBook", "MacBook", ""},
+		{"GmbH", "GmbH", ""},
+	}
+
+	matcher := legalform.NewMatcher(legalform.Default, legalform.WithCaseSplitting())
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			actualCompany, actualLegalForm := matcher.Strip(c.input)
+			assert.Equal(t, c.expectedCompanyName, actualCompany)
+			assert.Equal(t, c.expectedLegalForm, actualLegalForm)
+		})
+	}
+
+	actualCompany, actualLegalForm := legalform.DefaultMatcher().Strip("ExampleGmbH")
+	assert.Equal(t, "ExampleGmbH", actualCompany)
+	assert.Equal(t, "", actualLegalForm)
+}
+
+func TestDefaultMatcherAlias(t *testing.T) {
+	assert.Equal(t, "gmbh", legalform.DefaultMatcher().Alias("DE", "Gesellschaft mit beschränkter Haftung"))
+	assert.Same(t, legalform.DefaultMatcher(), legalform.DefaultMatcher())
+}
+
+func TestAtomicMatcher(t *testing.T) {
+	gmbh := legalform.NewMatcher(legalform.LegalForms{"gmbh": struct{}{}})
+	ag := legalform.NewMatcher(legalform.LegalForms{"ag": struct{}{}})
+	current := legalform.NewAtomicMatcher(gmbh)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, legalForm := current.Load().Strip("Example GmbH")
+				assert.Contains(t, []string{"GmbH", ""}, legalForm)
+			}
+		}()
+	}
+	assert.Same(t, gmbh, current.Swap(ag))
+	wg.Wait()
+
+	_, legalForm := current.Load().Strip("Example AG")
+	assert.Equal(t, "AG", legalForm)
+
+	var empty legalform.AtomicMatcher
+	assert.Nil(t, empty.Load())
+}
+
+func FuzzMatcher(f *testing.F) {
+	for _, input := range matcherInputs {
+		f.Add(input)
+	}
+	matcher := legalform.NewMatcher(legalform.Default)
+	f.Fuzz(func(t *testing.T, input string) {
+		assertSameResults(t, matcher, legalform.Default, input)
+	})
+}
+
+func assertSameResults(t *testing.T, matcher *legalform.Matcher, forms legalform.LegalForms, input string) {
+	t.Helper()
+
+	expectedName, expectedLegalForm := forms.Strip(input)
+	actualName, actualLegalForm := matcher.Strip(input)
+	assert.Equal(t, expectedName, actualName)
+	assert.Equal(t, expectedLegalForm, actualLegalForm)
+
+	expectedName, expectedLegalForm, expectedRemainder := forms.StripMiddle(input)
+	actualName, actualLegalForm, actualRemainder := matcher.StripMiddle(input)
+	assert.Equal(t, expectedName, actualName)
+	assert.Equal(t, expectedLegalForm, actualLegalForm)
+	assert.Equal(t, expectedRemainder, actualRemainder)
+
+	expectedName, expectedPrefix, expectedSuffix := forms.StripPrefix(input)
+	actualName, actualPrefix, actualSuffix := matcher.StripPrefix(input)
+	assert.Equal(t, expectedName, actualName)
+	assert.Equal(t, expectedPrefix, actualPrefix)
+	assert.Equal(t, expectedSuffix, actualSuffix)
+
+	assert.Equal(t, forms.Parse(input), matcher.Parse(input))
+	assert.Equal(t, slices.Collect(forms.Candidates(input)), slices.Collect(matcher.Candidates(input)))
+}
+
+func BenchmarkMatcherStrip(b *testing.B) {
+	matcher := legalform.NewMatcher(legalform.Default)
+	input := "Some Example S. A. de C. V., F. I. en I. D."
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = matcher.Strip(input)
+	}
+}
+
+func BenchmarkMatcherStripMiddle(b *testing.B) {
+	matcher := legalform.NewMatcher(legalform.Default)
+	input := "Example LLC GmbH & Co. KG Some Street Name No 1"
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = matcher.StripMiddle(input)
+	}
+}
@@ -0,0 +1,345 @@
+package legalform
+
+import (
+	"slices"
+)
+
+// Category describes the kind of organization that a legal form represents.
+type Category int
+
+// The categories of legal forms.
+const (
+	CategoryUnknown Category = iota
+	CategoryCorporation
+	CategoryPartnership
+	CategorySoleProprietorship
+	CategoryNonProfit
+	CategoryCooperative
+	CategoryPublicBody
+	CategoryFund
+	CategoryBranch
+)
+
+var categoryNames = map[Category]string{
+	CategoryUnknown:            "unknown",
+	CategoryCorporation:        "corporation",
+	CategoryPartnership:        "partnership",
+	CategorySoleProprietorship: "sole proprietorship",
+	CategoryNonProfit:          "non-profit",
+	CategoryCooperative:        "cooperative",
+	CategoryPublicBody:         "public body",
+	CategoryFund:               "fund",
+	CategoryBranch:             "branch",
+}
+
+// String returns the human readable name of the category.
+func (c Category) String() string {
+	if name, ok := categoryNames[c]; ok {
+		return name
+	}
+	return categoryNames[CategoryUnknown]
+}
+
+// Form describes a legal form within a set of countries.
+type Form struct {
+	// Key is the cleaned legal form, e.g. "gmbh".
+	Key string
+	// Countries are the ISO country codes in which the legal form is valid.
+	// "*" represents every country.
+	Countries []string
+	// Name is the full name of the legal form in the local language.
+	Name string
+	// Description is a short English description of the legal form.
+	Description string
+	// Category is the kind of organization the legal form represents.
+	Category Category
+	// LimitedLiability is true if the liability of all owners is limited.
+	LimitedLiability bool
+}
+
+// ValidIn checks if the legal form is valid in the country. Both "GB" and
+// "UK" refer to the United Kingdom.
+func (f Form) ValidIn(country string) bool {
+	return f.listed(country) || slices.Contains(f.Countries, "*")
+}
+
+// listed checks if the country is explicitly listed in the countries of the
+// form.
+func (f Form) listed(country string) bool {
+	country = elfCountry(country)
+	return slices.ContainsFunc(f.Countries, func(c string) bool {
+		return elfCountry(c) == country
+	})
+}
+
+// Registry represents a data structure that provides metadata for legal
+// forms.
+//
+// The same cleaned legal form can have different meanings in different
+// countries, e.g. "sa" is a public limited company in France, but a
+// cooperative in Norway. Hence each key can have multiple forms.
+//
+// In most cases, you want to simply use the predefined DefaultRegistry
+// instance.
+type Registry map[string][]Form
+
+// NewRegistry creates a new registry from the forms.
+func NewRegistry(forms ...Form) Registry {
+	r := Registry{}
+	for _, form := range forms {
+		r[form.Key] = append(r[form.Key], form)
+	}
+	return r
+}
+
+// Find returns the metadata for the legal form in the country.
+//
+// The legal form can be provided as it was returned by Strip. If the cleaned
+// legal form itself is unknown, then its alias from DefaultAliases is used,
+// e.g. "Gesellschaft mit beschränkter Haftung" will return the form for
+// "gmbh".
+//
+// If the country is empty, then the first known form is returned regardless of
+// its countries. Otherwise only forms that are valid in the country are
+// returned, preferring those that explicitly list the country over those that
+// are valid in every country. Both "GB" and "UK" refer to the United Kingdom.
+func (r Registry) Find(country, legalForm string) (Form, bool) {
+	if form, ok := r.find(country, cleanKey(legalForm)); ok {
+		return form, true
+	}
+	return r.find(country, DefaultAliases.Find(elfAliasCountry(country), legalForm))
+}
+
+func (r Registry) find(country, key string) (Form, bool) {
+	forms := r[key]
+	if country == "" && len(forms) > 0 {
+		return forms[0], true
+	}
+	for _, form := range forms {
+		if form.listed(country) {
+			return form, true
+		}
+	}
+	for _, form := range forms {
+		if form.ValidIn(country) {
+			return form, true
+		}
+	}
+	return Form{}, false
+}
+
+// eeaCountries are the countries of the European Economic Area, in which the
+// European legal forms are valid.
+var eeaCountries = []string{
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU", "IE",
+	"IS", "IT", "LI", "LT", "LU", "LV", "MT", "NL", "NO", "PL", "PT", "RO", "SE", "SI", "SK",
+}
+
+// DefaultRegistry contains the metadata for the most common legal forms.
+var DefaultRegistry = NewRegistry(
+	// common law
+	Form{Key: "ltd", Countries: []string{"*"}, Name: "Limited", Description: "private company limited by shares", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "coltd", Countries: []string{"*"}, Name: "Company Limited", Description: "limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "plc", Countries: []string{"GB", "IE"}, Name: "Public Limited Company", Description: "public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "llp", Countries: []string{"GB", "US", "IN", "SG"}, Name: "Limited Liability Partnership", Description: "limited liability partnership", Category: CategoryPartnership, LimitedLiability: true},
+	Form{Key: "lp", Countries: []string{"GB", "US", "IE", "CA", "KY"}, Name: "Limited Partnership", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "gp", Countries: []string{"US"}, Name: "General Partnership", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "cic", Countries: []string{"GB"}, Name: "Community Interest Company", Description: "community interest company", Category: CategoryNonProfit, LimitedLiability: true},
+	Form{Key: "inc", Countries: []string{"*"}, Name: "Incorporated", Description: "corporation", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "corp", Countries: []string{"*"}, Name: "Corporation", Description: "corporation", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "llc", Countries: []string{"US", "AE", "KY", "JP", "RU"}, Name: "Limited Liability Company", Description: "limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "pllc", Countries: []string{"US"}, Name: "Professional Limited Liability Company", Description: "professional limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "lllp", Countries: []string{"US"}, Name: "Limited Liability Limited Partnership", Description: "limited liability limited partnership", Category: CategoryPartnership, LimitedLiability: true},
+	Form{Key: "pc", Countries: []string{"US"}, Name: "Professional Corporation", Description: "professional corporation", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ltee", Countries: []string{"CA"}, Name: "Limitée", Description: "limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "pvtltd", Countries: []string{"IN", "PK", "BD", "LK", "NP"}, Name: "Private Limited", Description: "private limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "pteltd", Countries: []string{"SG"}, Name: "Private Limited", Description: "private limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ptyltd", Countries: []string{"AU", "ZA"}, Name: "Proprietary Limited", Description: "proprietary limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "npc", Countries: []string{"ZA"}, Name: "Non-Profit Company", Description: "non-profit company", Category: CategoryNonProfit, LimitedLiability: true},
+	Form{Key: "sdnbhd", Countries: []string{"MY"}, Name: "Sendirian Berhad", Description: "private limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "bhd", Countries: []string{"MY"}, Name: "Berhad", Description: "public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "trust", Countries: []string{"*"}, Name: "Trust", Description: "trust", Category: CategoryFund},
+	Form{Key: "reit", Countries: []string{"*"}, Name: "Real Estate Investment Trust", Description: "real estate investment trust", Category: CategoryFund, LimitedLiability: true},
+
+	// European
+	Form{Key: "se", Countries: eeaCountries, Name: "Societas Europaea", Description: "European public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sce", Countries: eeaCountries, Name: "Societas Cooperativa Europaea", Description: "European cooperative society", Category: CategoryCooperative, LimitedLiability: true},
+	Form{Key: "eeig", Countries: eeaCountries, Name: "European Economic Interest Grouping", Description: "European economic interest grouping", Category: CategoryPartnership},
+	Form{Key: "ewiv", Countries: []string{"DE", "AT", "LI", "LU", "BE"}, Name: "Europäische wirtschaftliche Interessenvereinigung", Description: "European economic interest grouping", Category: CategoryPartnership},
+
+	// DACH
+	Form{Key: "gmbh", Countries: []string{"DE", "AT", "CH", "LI"}, Name: "Gesellschaft mit beschränkter Haftung", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ggmbh", Countries: []string{"DE"}, Name: "gemeinnützige Gesellschaft mit beschränkter Haftung", Description: "non-profit limited liability company", Category: CategoryNonProfit, LimitedLiability: true},
+	Form{Key: "ug", Countries: []string{"DE"}, Name: "Unternehmergesellschaft (haftungsbeschränkt)", Description: "mini limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ughaftungsbeschrankt", Countries: []string{"DE"}, Name: "Unternehmergesellschaft (haftungsbeschränkt)", Description: "mini limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ag", Countries: []string{"DE", "AT", "CH", "LI"}, Name: "Aktiengesellschaft", Description: "stock corporation", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "kgaa", Countries: []string{"DE", "AT"}, Name: "Kommanditgesellschaft auf Aktien", Description: "partnership limited by shares", Category: CategoryCorporation},
+	Form{Key: "kg", Countries: []string{"DE", "AT", "CH", "LI"}, Name: "Kommanditgesellschaft", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "gmbhcokg", Countries: []string{"DE", "AT"}, Name: "GmbH & Co. KG", Description: "limited partnership with a limited liability company as general partner", Category: CategoryPartnership, LimitedLiability: true},
+	Form{Key: "ohg", Countries: []string{"DE"}, Name: "Offene Handelsgesellschaft", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "og", Countries: []string{"AT"}, Name: "Offene Gesellschaft", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "gbr", Countries: []string{"DE"}, Name: "Gesellschaft bürgerlichen Rechts", Description: "civil law partnership", Category: CategoryPartnership},
+	Form{Key: "partg", Countries: []string{"DE"}, Name: "Partnerschaftsgesellschaft", Description: "professional partnership", Category: CategoryPartnership},
+	Form{Key: "ek", Countries: []string{"DE"}, Name: "eingetragener Kaufmann", Description: "registered sole trader", Category: CategorySoleProprietorship},
+	Form{Key: "ekfm", Countries: []string{"DE"}, Name: "eingetragener Kaufmann", Description: "registered sole trader", Category: CategorySoleProprietorship},
+	Form{Key: "ev", Countries: []string{"DE"}, Name: "eingetragener Verein", Description: "registered association", Category: CategoryNonProfit, LimitedLiability: true},
+	Form{Key: "eg", Countries: []string{"DE", "AT"}, Name: "eingetragene Genossenschaft", Description: "registered cooperative", Category: CategoryCooperative, LimitedLiability: true},
+	Form{Key: "gesmbh", Countries: []string{"AT"}, Name: "Gesellschaft mit beschränkter Haftung", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sagl", Countries: []string{"CH"}, Name: "Società a garanzia limitata", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+
+	// French, Benelux
+	Form{Key: "sa", Countries: []string{"FR", "BE", "LU", "CH", "MC"}, Name: "Société anonyme", Description: "public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sas", Countries: []string{"FR", "LU"}, Name: "Société par actions simplifiée", Description: "simplified joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sasu", Countries: []string{"FR"}, Name: "Société par actions simplifiée unipersonnelle", Description: "single-shareholder simplified joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sarl", Countries: []string{"FR", "LU", "CH", "BE", "MA", "TN"}, Name: "Société à responsabilité limitée", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "eurl", Countries: []string{"FR"}, Name: "Entreprise unipersonnelle à responsabilité limitée", Description: "single-member limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "snc", Countries: []string{"FR", "LU", "BE"}, Name: "Société en nom collectif", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "scs", Countries: []string{"FR", "LU", "BE"}, Name: "Société en commandite simple", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "sca", Countries: []string{"FR", "LU", "BE"}, Name: "Société en commandite par actions", Description: "partnership limited by shares", Category: CategoryCorporation},
+	Form{Key: "sci", Countries: []string{"FR"}, Name: "Société civile immobilière", Description: "real estate partnership", Category: CategoryPartnership},
+	Form{Key: "eirl", Countries: []string{"FR"}, Name: "Entrepreneur individuel à responsabilité limitée", Description: "sole proprietorship with limited liability", Category: CategorySoleProprietorship, LimitedLiability: true},
+	Form{Key: "ei", Countries: []string{"FR"}, Name: "Entreprise individuelle", Description: "sole proprietorship", Category: CategorySoleProprietorship},
+	Form{Key: "etablissementpublic", Countries: []string{"FR"}, Name: "Établissement public", Description: "public institution", Category: CategoryPublicBody},
+	Form{Key: "sicav", Countries: []string{"LU", "FR", "BE", "CH", "IT", "ES"}, Name: "Société d'investissement à capital variable", Description: "open-ended investment company", Category: CategoryFund, LimitedLiability: true},
+	Form{Key: "sicaf", Countries: []string{"LU", "FR", "BE", "IT"}, Name: "Société d'investissement à capital fixe", Description: "closed-ended investment company", Category: CategoryFund, LimitedLiability: true},
+	Form{Key: "fcp", Countries: []string{"LU", "FR"}, Name: "Fonds commun de placement", Description: "mutual fund", Category: CategoryFund},
+	Form{Key: "asbl", Countries: []string{"BE", "LU"}, Name: "Association sans but lucratif", Description: "non-profit association", Category: CategoryNonProfit, LimitedLiability: true},
+	Form{Key: "aisbl", Countries: []string{"BE"}, Name: "Association internationale sans but lucratif", Description: "international non-profit association", Category: CategoryNonProfit, LimitedLiability: true},
+	Form{Key: "vzw", Countries: []string{"BE"}, Name: "Vereniging zonder winstoogmerk", Description: "non-profit association", Category: CategoryNonProfit, LimitedLiability: true},
+	Form{Key: "sprl", Countries: []string{"BE"}, Name: "Société privée à responsabilité limitée", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "bvba", Countries: []string{"BE"}, Name: "Besloten vennootschap met beperkte aansprakelijkheid", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "cvba", Countries: []string{"BE"}, Name: "Coöperatieve vennootschap met beperkte aansprakelijkheid", Description: "cooperative with limited liability", Category: CategoryCooperative, LimitedLiability: true},
+	Form{Key: "scrl", Countries: []string{"BE"}, Name: "Société coopérative à responsabilité limitée", Description: "cooperative with limited liability", Category: CategoryCooperative, LimitedLiability: true},
+	Form{Key: "bv", Countries: []string{"NL", "BE"}, Name: "Besloten vennootschap", Description: "private limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "nv", Countries: []string{"NL", "BE"}, Name: "Naamloze vennootschap", Description: "public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "vof", Countries: []string{"NL", "BE"}, Name: "Vennootschap onder firma", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "cv", Countries: []string{"NL"}, Name: "Commanditaire vennootschap", Description: "limited partnership", Category: CategoryPartnership},
+
+	// Southern Europe and Latin America
+	Form{Key: "spa", Countries: []string{"IT"}, Name: "Società per azioni", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "srl", Countries: []string{"IT"}, Name: "Società a responsabilità limitata", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "srls", Countries: []string{"IT"}, Name: "Società a responsabilità limitata semplificata", Description: "simplified private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "snc", Countries: []string{"IT"}, Name: "Società in nome collettivo", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "sas", Countries: []string{"IT"}, Name: "Società in accomandita semplice", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "scarl", Countries: []string{"IT"}, Name: "Società consortile a responsabilità limitata", Description: "consortium company with limited liability", Category: CategoryCooperative, LimitedLiability: true},
+	Form{Key: "sc", Countries: []string{"IT"}, Name: "Società cooperativa", Description: "cooperative", Category: CategoryCooperative, LimitedLiability: true},
+	Form{Key: "sa", Countries: []string{"ES", "AR", "BO", "CL", "CO", "CR", "DO", "EC", "GT", "HN", "MX", "NI", "PA", "PE", "PY", "SV", "UY", "VE"}, Name: "Sociedad anónima", Description: "public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sl", Countries: []string{"ES"}, Name: "Sociedad limitada", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "slu", Countries: []string{"ES"}, Name: "Sociedad limitada unipersonal", Description: "single-member private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "slne", Countries: []string{"ES"}, Name: "Sociedad limitada nueva empresa", Description: "new enterprise limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "scoop", Countries: []string{"ES"}, Name: "Sociedad cooperativa", Description: "cooperative", Category: CategoryCooperative, LimitedLiability: true},
+	Form{Key: "srl", Countries: []string{"AR", "BO", "PE", "PY", "UY"}, Name: "Sociedad de responsabilidad limitada", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sas", Countries: []string{"CO", "AR"}, Name: "Sociedad por acciones simplificada", Description: "simplified joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ltda", Countries: []string{"BR", "CO", "CL", "EC"}, Name: "Limitada", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sadecv", Countries: []string{"MX", "SV"}, Name: "Sociedad anónima de capital variable", Description: "public limited company with variable capital", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sapidecv", Countries: []string{"MX"}, Name: "Sociedad anónima promotora de inversión de capital variable", Description: "investment promotion company with variable capital", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sderldecv", Countries: []string{"MX"}, Name: "Sociedad de responsabilidad limitada de capital variable", Description: "limited liability company with variable capital", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sc", Countries: []string{"MX"}, Name: "Sociedad civil", Description: "civil partnership", Category: CategoryPartnership},
+	Form{Key: "sa", Countries: []string{"PT", "BR"}, Name: "Sociedade anónima", Description: "public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "lda", Countries: []string{"PT"}, Name: "Sociedade por quotas", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "eireli", Countries: []string{"BR"}, Name: "Empresa individual de responsabilidade limitada", Description: "single-member limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+
+	// Nordic and Baltic
+	Form{Key: "as", Countries: []string{"NO"}, Name: "Aksjeselskap", Description: "private limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "as", Countries: []string{"DK"}, Name: "Aktieselskab", Description: "public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "as", Countries: []string{"EE"}, Name: "Aktsiaselts", Description: "public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "as", Countries: []string{"LV"}, Name: "Akciju sabiedrība", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "as", Countries: []string{"CZ", "SK"}, Name: "Akciová společnost", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "as", Countries: []string{"TR"}, Name: "Anonim şirket", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "asa", Countries: []string{"NO"}, Name: "Allmennaksjeselskap", Description: "public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ans", Countries: []string{"NO"}, Name: "Ansvarlig selskap", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "da", Countries: []string{"NO"}, Name: "Selskap med delt ansvar", Description: "partnership with shared liability", Category: CategoryPartnership},
+	Form{Key: "enk", Countries: []string{"NO"}, Name: "Enkeltpersonforetak", Description: "sole proprietorship", Category: CategorySoleProprietorship},
+	Form{Key: "nuf", Countries: []string{"NO"}, Name: "Norskregistrert utenlandsk foretak", Description: "branch of a foreign company", Category: CategoryBranch},
+	Form{Key: "kf", Countries: []string{"NO"}, Name: "Kommunalt foretak", Description: "municipal enterprise", Category: CategoryPublicBody},
+	Form{Key: "sa", Countries: []string{"NO"}, Name: "Samvirkeforetak", Description: "cooperative", Category: CategoryCooperative, LimitedLiability: true},
+	Form{Key: "brl", Countries: []string{"NO"}, Name: "Borettslag", Description: "housing cooperative", Category: CategoryCooperative, LimitedLiability: true},
+	Form{Key: "bbl", Countries: []string{"NO"}, Name: "Boligbyggelag", Description: "housing construction cooperative", Category: CategoryCooperative, LimitedLiability: true},
+	Form{Key: "ks", Countries: []string{"NO"}, Name: "Kommandittselskap", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "ks", Countries: []string{"DK"}, Name: "Kommanditselskab", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "aps", Countries: []string{"DK"}, Name: "Anpartsselskab", Description: "private limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "is", Countries: []string{"DK"}, Name: "Interessentskab", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "ab", Countries: []string{"SE", "FI"}, Name: "Aktiebolag", Description: "limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "hb", Countries: []string{"SE"}, Name: "Handelsbolag", Description: "trading partnership", Category: CategoryPartnership},
+	Form{Key: "kb", Countries: []string{"SE"}, Name: "Kommanditbolag", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "oy", Countries: []string{"FI"}, Name: "Osakeyhtiö", Description: "private limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "oyj", Countries: []string{"FI"}, Name: "Julkinen osakeyhtiö", Description: "public limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ky", Countries: []string{"FI"}, Name: "Kommandiittiyhtiö", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "ou", Countries: []string{"EE"}, Name: "Osaühing", Description: "private limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sia", Countries: []string{"LV"}, Name: "Sabiedrība ar ierobežotu atbildību", Description: "private limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "uab", Countries: []string{"LT"}, Name: "Uždaroji akcinė bendrovė", Description: "private limited company", Category: CategoryCorporation, LimitedLiability: true},
+
+	// Central and Eastern Europe
+	Form{Key: "spzoo", Countries: []string{"PL"}, Name: "Spółka z ograniczoną odpowiedzialnością", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sa", Countries: []string{"PL"}, Name: "Spółka akcyjna", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "spk", Countries: []string{"PL"}, Name: "Spółka komandytowa", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "spj", Countries: []string{"PL"}, Name: "Spółka jawna", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "sro", Countries: []string{"CZ", "SK"}, Name: "Společnost s ručením omezeným", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "kft", Countries: []string{"HU"}, Name: "Korlátolt felelősségű társaság", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "zrt", Countries: []string{"HU"}, Name: "Zártkörűen működő részvénytársaság", Description: "private company limited by shares", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "nyrt", Countries: []string{"HU"}, Name: "Nyilvánosan működő részvénytársaság", Description: "public company limited by shares", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "bt", Countries: []string{"HU"}, Name: "Betéti társaság", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "kkt", Countries: []string{"HU"}, Name: "Közkereseti társaság", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "srl", Countries: []string{"RO"}, Name: "Societate cu răspundere limitată", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sa", Countries: []string{"RO"}, Name: "Societate pe acțiuni", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "sc", Countries: []string{"RO"}, Name: "Societate comercială", Description: "commercial company", Category: CategoryCorporation},
+	Form{Key: "pfa", Countries: []string{"RO"}, Name: "Persoană fizică autorizată", Description: "authorized self-employed person", Category: CategorySoleProprietorship},
+	Form{Key: "ood", Countries: []string{"BG"}, Name: "Druzhestvo s ogranichena otgovornost", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "eood", Countries: []string{"BG"}, Name: "Ednolichno druzhestvo s ogranichena otgovornost", Description: "single-member private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ad", Countries: []string{"BG"}, Name: "Aktsionerno druzhestvo", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ad", Countries: []string{"RS", "BA", "ME", "MK"}, Name: "Akcionarsko društvo", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "et", Countries: []string{"BG"}, Name: "Ednolichen targovets", Description: "sole trader", Category: CategorySoleProprietorship},
+	Form{Key: "doo", Countries: []string{"HR", "RS", "BA", "ME", "MK", "SI"}, Name: "Društvo s ograničenom odgovornošću", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "dd", Countries: []string{"HR", "SI", "BA"}, Name: "Dioničko društvo", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "epe", Countries: []string{"GR"}, Name: "Etaireía Periorisménis Euthýnis", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ike", Countries: []string{"GR"}, Name: "Idiotikí Kefalaiouchikí Etaireía", Description: "private company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "oe", Countries: []string{"GR"}, Name: "Omórrythmi Etaireía", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "ee", Countries: []string{"GR"}, Name: "Eterórrythmi Etaireía", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "ltdsti", Countries: []string{"TR"}, Name: "Limited şirketi", Description: "private limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+
+	// RU
+	Form{Key: "ооо", Countries: []string{"RU"}, Name: "Общество с ограниченной ответственностью", Description: "limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ао", Countries: []string{"RU"}, Name: "Акционерное общество", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "пао", Countries: []string{"RU"}, Name: "Публичное акционерное общество", Description: "public joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "зао", Countries: []string{"RU"}, Name: "Закрытое акционерное общество", Description: "closed joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "оао", Countries: []string{"RU"}, Name: "Открытое акционерное общество", Description: "open joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "гуп", Countries: []string{"RU"}, Name: "Государственное унитарное предприятие", Description: "state unitary enterprise", Category: CategoryPublicBody, LimitedLiability: true},
+	Form{Key: "муп", Countries: []string{"RU"}, Name: "Муниципальное унитарное предприятие", Description: "municipal unitary enterprise", Category: CategoryPublicBody, LimitedLiability: true},
+	Form{Key: "пт", Countries: []string{"RU"}, Name: "Полное товарищество", Description: "general partnership", Category: CategoryPartnership},
+	Form{Key: "тв", Countries: []string{"RU"}, Name: "Товарищество на вере", Description: "limited partnership", Category: CategoryPartnership},
+
+	// VN
+	Form{Key: "llc", Countries: []string{"VN"}, Name: "Công ty trách nhiệm hữu hạn", Description: "limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "jsc", Countries: []string{"VN"}, Name: "Công ty cổ phần", Description: "joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "dntn", Countries: []string{"VN"}, Name: "Doanh nghiệp tư nhân", Description: "private enterprise", Category: CategorySoleProprietorship},
+
+	// JP
+	Form{Key: "kk", Countries: []string{"JP"}, Name: "株式会社", Description: "stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "gk", Countries: []string{"JP"}, Name: "合同会社", Description: "limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "yk", Countries: []string{"JP"}, Name: "有限会社", Description: "limited company (legacy)", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "gmk", Countries: []string{"JP"}, Name: "合名会社", Description: "general partnership company", Category: CategoryPartnership},
+	Form{Key: "gsk", Countries: []string{"JP"}, Name: "合資会社", Description: "limited partnership company", Category: CategoryPartnership},
+	Form{Key: "医", Countries: []string{"JP"}, Name: "医療法人", Description: "medical corporation", Category: CategoryNonProfit, LimitedLiability: true},
+	Form{Key: "地方公共団体", Countries: []string{"JP"}, Name: "地方公共団体", Description: "local public entity", Category: CategoryPublicBody},
+	Form{Key: "国の機関", Countries: []string{"JP"}, Name: "国の機関", Description: "national agency", Category: CategoryPublicBody},
+
+	// KR
+	Form{Key: "jsc", Countries: []string{"KR"}, Name: "주식회사", Description: "stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "ltd", Countries: []string{"KR"}, Name: "유한회사", Description: "limited company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "llc", Countries: []string{"KR"}, Name: "유한책임회사", Description: "limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "lp", Countries: []string{"KR"}, Name: "합자회사", Description: "limited partnership company", Category: CategoryPartnership},
+	Form{Key: "gp", Countries: []string{"KR"}, Name: "합명회사", Description: "general partnership company", Category: CategoryPartnership},
+
+	// CN
+	Form{Key: "ltd", Countries: []string{"CN"}, Name: "有限公司", Description: "limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "jsc", Countries: []string{"CN"}, Name: "股份有限公司", Description: "company limited by shares", Category: CategoryCorporation, LimitedLiability: true},
+
+	// Asia and Middle East
+	Form{Key: "pt", Countries: []string{"ID"}, Name: "Perseroan Terbatas", Description: "limited liability company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "tbk", Countries: []string{"ID"}, Name: "Perseroan Terbatas Terbuka", Description: "public listed company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "cv", Countries: []string{"ID"}, Name: "Commanditaire Vennootschap", Description: "limited partnership", Category: CategoryPartnership},
+	Form{Key: "fze", Countries: []string{"AE"}, Name: "Free Zone Establishment", Description: "single-shareholder free zone company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "fzc", Countries: []string{"AE"}, Name: "Free Zone Company", Description: "free zone company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "pjsc", Countries: []string{"AE", "RU"}, Name: "Public Joint Stock Company", Description: "public joint-stock company", Category: CategoryCorporation, LimitedLiability: true},
+	Form{Key: "branchofaforeigncompany", Countries: []string{"AE"}, Name: "Branch of a Foreign Company", Description: "branch of a foreign company", Category: CategoryBranch},
+)
@@ -0,0 +1,226 @@
+package legalform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestRegistryFind(t *testing.T) {
+	cases := []struct {
+		country                  string
+		legalForm                string
+		expectedFound            bool
+		expectedKey              string
+		expectedName             string
+		expectedCategory         legalform.Category
+		expectedLimitedLiability bool
+	}{
+		{
+			country:                  "DE",
+			legalForm:                "GmbH",
+			expectedFound:            true,
+			expectedKey:              "gmbh",
+			expectedName:             "Gesellschaft mit beschränkter Haftung",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "de",
+			legalForm:                "Gesellschaft mit beschränkter Haftung",
+			expectedFound:            true,
+			expectedKey:              "gmbh",
+			expectedName:             "Gesellschaft mit beschränkter Haftung",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "DE",
+			legalForm:                "KG",
+			expectedFound:            true,
+			expectedKey:              "kg",
+			expectedName:             "Kommanditgesellschaft",
+			expectedCategory:         legalform.CategoryPartnership,
+			expectedLimitedLiability: false,
+		},
+		{
+			country:                  "FR",
+			legalForm:                "S.A.",
+			expectedFound:            true,
+			expectedKey:              "sa",
+			expectedName:             "Société anonyme",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "NO",
+			legalForm:                "SA",
+			expectedFound:            true,
+			expectedKey:              "sa",
+			expectedName:             "Samvirkeforetak",
+			expectedCategory:         legalform.CategoryCooperative,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "KR",
+			legalForm:                "(주)",
+			expectedFound:            true,
+			expectedKey:              "jsc",
+			expectedName:             "주식회사",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "JP",
+			legalForm:                "株式会社",
+			expectedFound:            true,
+			expectedKey:              "kk",
+			expectedName:             "株式会社",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "CN",
+			legalForm:                "Ltd.",
+			expectedFound:            true,
+			expectedKey:              "ltd",
+			expectedName:             "有限公司",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "UK",
+			legalForm:                "Limited",
+			expectedFound:            true,
+			expectedKey:              "ltd",
+			expectedName:             "Limited",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "GB",
+			legalForm:                "plc",
+			expectedFound:            true,
+			expectedKey:              "plc",
+			expectedName:             "Public Limited Company",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "uk",
+			legalForm:                "PLC",
+			expectedFound:            true,
+			expectedKey:              "plc",
+			expectedName:             "Public Limited Company",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "DE",
+			legalForm:                "GmbH & Co.\tKG",
+			expectedFound:            true,
+			expectedKey:              "gmbhcokg",
+			expectedName:             "GmbH & Co. KG",
+			expectedCategory:         legalform.CategoryPartnership,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "CZ",
+			legalForm:                "s.r.o.",
+			expectedFound:            true,
+			expectedKey:              "sro",
+			expectedName:             "Společnost s ručením omezeným",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "FR",
+			legalForm:                "SASU",
+			expectedFound:            true,
+			expectedKey:              "sasu",
+			expectedName:             "Société par actions simplifiée unipersonnelle",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "FR",
+			legalForm:                "EURL",
+			expectedFound:            true,
+			expectedKey:              "eurl",
+			expectedName:             "Entreprise unipersonnelle à responsabilité limitée",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:                  "PT",
+			legalForm:                "Lda.",
+			expectedFound:            true,
+			expectedKey:              "lda",
+			expectedName:             "Sociedade por quotas",
+			expectedCategory:         legalform.CategoryCorporation,
+			expectedLimitedLiability: true,
+		},
+		{
+			country:       "US",
+			legalForm:     "GmbH",
+			expectedFound: false,
+		},
+		{
+			country:       "DE",
+			legalForm:     "Foobar",
+			expectedFound: false,
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			actual, found := legalform.DefaultRegistry.Find(c.country, c.legalForm)
+			assert.Equal(t, c.expectedFound, found)
+			assert.Equal(t, c.expectedKey, actual.Key)
+			assert.Equal(t, c.expectedName, actual.Name)
+			assert.Equal(t, c.expectedCategory, actual.Category)
+			assert.Equal(t, c.expectedLimitedLiability, actual.LimitedLiability)
+		})
+	}
+}
+
+func TestRegistryFindWithoutCountry(t *testing.T) {
+	actual, found := legalform.DefaultRegistry.Find("", "e.V.")
+	assert.True(t, found)
+	assert.Equal(t, legalform.CategoryNonProfit, actual.Category)
+	assert.True(t, actual.ValidIn("de"))
+	assert.False(t, actual.ValidIn("AT"))
+
+	actual, found = legalform.DefaultRegistry.Find("", "plc")
+	assert.True(t, found)
+	assert.Equal(t, []string{"GB", "IE"}, actual.Countries)
+	assert.True(t, actual.ValidIn("GB"))
+	assert.True(t, actual.ValidIn("uk"))
+}
+
+func TestDefaultRegistryKeysAreKnown(t *testing.T) {
+	aliases := map[string]struct{}{}
+	for _, countryAliases := range legalform.DefaultAliases {
+		for _, alias := range countryAliases {
+			aliases[alias] = struct{}{}
+		}
+	}
+
+	for key, forms := range legalform.DefaultRegistry {
+		_, inDefault := legalform.Default[key]
+		_, isAlias := aliases[key]
+		assert.True(t, inDefault || isAlias, key)
+		for _, form := range forms {
+			assert.Equal(t, key, form.Key)
+			assert.NotEmpty(t, form.Countries, key)
+			assert.NotEqual(t, legalform.CategoryUnknown, form.Category, key)
+		}
+	}
+}
+
+func TestCategoryString(t *testing.T) {
+	assert.Equal(t, "sole proprietorship", legalform.CategorySoleProprietorship.String())
+	assert.Equal(t, "unknown", legalform.Category(-1).String())
+}
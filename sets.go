@@ -0,0 +1,39 @@
+package legalform
+
+import "slices"
+
+// Union returns a new instance containing the legal forms that are in f or in
+// any of the others. It is identical to Merge.
+func (f LegalForms) Union(others ...LegalForms) LegalForms {
+	return f.Merge(others...)
+}
+
+// Intersect returns a new instance containing only the legal forms that are
+// in f and in all others.
+func (f LegalForms) Intersect(others ...LegalForms) LegalForms {
+	return f.filter(func(key string) bool {
+		return !slices.ContainsFunc(others, func(other LegalForms) bool {
+			return !other.has(key)
+		})
+	})
+}
+
+// Without returns a new instance containing only the legal forms of f that
+// are not in any of the others.
+func (f LegalForms) Without(others ...LegalForms) LegalForms {
+	return f.filter(func(key string) bool {
+		return !slices.ContainsFunc(others, func(other LegalForms) bool {
+			return other.has(key)
+		})
+	})
+}
+
+func (f LegalForms) filter(keep func(key string) bool) LegalForms {
+	filtered := LegalForms{}
+	for key := range f {
+		if keep(key) {
+			filtered[key] = struct{}{}
+		}
+	}
+	return filtered
+}
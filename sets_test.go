@@ -0,0 +1,83 @@
+package legalform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestSetOperations(t *testing.T) {
+	a := legalform.NewLegalForms("GmbH", "AG", "Ltd")
+	b := legalform.NewLegalForms("AG", "Ltd", "Inc")
+	c := legalform.NewLegalForms("Ltd", "SA")
+
+	cases := []struct {
+		actual   legalform.LegalForms
+		expected legalform.LegalForms
+	}{
+		{a.Union(b, c), legalform.NewLegalForms("GmbH", "AG", "Ltd", "Inc", "SA")},
+		{a.Union(), a},
+		{a.Intersect(b), legalform.NewLegalForms("AG", "Ltd")},
+		{a.Intersect(b, c), legalform.NewLegalForms("Ltd")},
+		{a.Intersect(), a},
+		{a.Without(b), legalform.NewLegalForms("GmbH")},
+		{a.Without(c), legalform.NewLegalForms("GmbH", "AG")},
+		{a.Without(b, c), legalform.NewLegalForms("GmbH")},
+		{legalform.LegalForms(nil).Intersect(a), legalform.LegalForms{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			assert.Equal(t, c.expected, c.actual)
+		})
+	}
+
+	assert.Equal(t, legalform.NewLegalForms("GmbH", "AG", "Ltd"), a, "must not be modified")
+}
+
+func TestForCountries(t *testing.T) {
+	dach := legalform.Default.ForCountries("de", "AT", "CH")
+
+	for _, key := range []string{"gmbh", "ag", "kg", "ug", "ev", "ltd", "co", "gmbhcokgaa", "agcokgaa", "agcoohg", "auslgengmbh"} {
+		assert.Contains(t, dach, key)
+	}
+	for _, key := range []string{"as", "oy", "bv", "llc", "株式会社", "br"} {
+		assert.NotContains(t, dach, key)
+	}
+
+	name, legalForm := dach.Strip("Example AS")
+	assert.Equal(t, "Example AS", name)
+	assert.Equal(t, "", legalForm)
+
+	name, legalForm = dach.Strip("Example SA")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "SA", legalForm)
+
+	name, legalForm = dach.Without(legalform.NewLegalForms("SA")).Strip("Example SA")
+	assert.Equal(t, "Example SA", name)
+	assert.Equal(t, "", legalForm)
+
+	name, legalForm = dach.Strip("Example GmbH")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "GmbH", legalForm)
+
+	name, legalForm = dach.Strip("Example GmbH & Co. KGaA")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "GmbH & Co. KGaA", legalForm)
+
+	assert.Equal(t, legalform.Default.ForCountries("UK"), legalform.Default.ForCountries("GB"))
+	assert.Empty(t, legalform.NewLegalForms("Oy", "BR").ForCountries("DE"))
+	assert.Equal(t, legalform.NewLegalForms("Foo"), legalform.NewLegalForms("Foo").ForCountries("DE"))
+	assert.Empty(t, legalform.Default.ForCountries())
+}
+
+func TestCountries(t *testing.T) {
+	assert.Equal(t, []string{"AT", "CH", "DE", "LI"}, legalform.Default.Countries("GmbH"))
+	assert.Equal(t, []string{"FI"}, legalform.Default.Countries("oy"))
+	assert.Contains(t, legalform.Default.Countries("Ltd."), "*")
+	assert.Nil(t, legalform.Default.Countries("Foo"))
+	assert.Nil(t, legalform.NewLegalForms("Foo").Countries("Foo"))
+	assert.Nil(t, legalform.NewLegalForms("Foo").Countries("GmbH"))
+}
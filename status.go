@@ -0,0 +1,217 @@
+package legalform
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Status describes the lifecycle status of a company as indicated by a marker
+// after its legal form, e.g. "i.L." in "Example GmbH i.L.".
+type Status int
+
+// Possible statuses of companies.
+const (
+	// StatusNone is used if the name does not contain a status marker.
+	StatusNone Status = iota
+	// StatusLiquidation companies are being wound up, e.g. "in liquidation",
+	// "i.L." or "en liquidation".
+	StatusLiquidation
+	// StatusAdministration companies are subject to administration,
+	// insolvency or bankruptcy proceedings, e.g. "in administration",
+	// "in Insolvenz" or "in Konkurs".
+	StatusAdministration
+	// StatusReceivership companies are managed by a receiver, e.g.
+	// "in receivership".
+	StatusReceivership
+	// StatusFormation companies are not yet registered, e.g. "i.G.",
+	// "in Gründung" or "in oprichting".
+	StatusFormation
+	// StatusDissolved companies no longer exist, e.g. "dissolved" or
+	// "aufgelöst".
+	StatusDissolved
+)
+
+var statusNames = map[Status]string{
+	StatusNone:           "none",
+	StatusLiquidation:    "liquidation",
+	StatusAdministration: "administration",
+	StatusReceivership:   "receivership",
+	StatusFormation:      "formation",
+	StatusDissolved:      "dissolved",
+}
+
+// String returns the English name of the status.
+func (s Status) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+	return statusNames[StatusNone]
+}
+
+// statusMarkers are the markers for each status as they are written after the
+// legal form.
+var statusMarkers = map[Status][]string{
+	StatusLiquidation: {
+		"in liquidation", "i.L.", "i. Liq.", "in Abwicklung",
+		"en liquidation", "en liquidation amiable", "en liquidation judiciaire",
+		"in liquidazione", "in liq.",
+		"en liquidación",
+		"em liquidação",
+		"in liquidatie", "in vereffening",
+		"w likwidacji",
+		"i likvidation", "under likvidation", "under avvikling", "under afvikling",
+		"selvitystilassa",
+		"v likvidaci", "v likvidácii",
+		"în lichidare",
+	},
+	StatusAdministration: {
+		"in administration",
+		"in Insolvenz", "in Konkurs",
+		"en redressement judiciaire", "en faillite",
+		"in staat van faillissement",
+		"in amministrazione straordinaria", "in fallimento",
+		"en concurso", "en quiebra",
+		"w upadłości",
+		"în insolvență",
+	},
+	StatusReceivership: {
+		"in receivership", "receiver appointed", "receivers appointed",
+		"unter Zwangsverwaltung",
+	},
+	StatusFormation: {
+		"i.G.", "i.Gr.", "in Gründung",
+		"en formation", "en cours de formation",
+		"en formación", "en constitución",
+		"in costituzione",
+		"in oprichting", "i.o.",
+		"em constituição",
+	},
+	StatusDissolved: {
+		"dissolved",
+		"aufgelöst",
+		"dissoute", "dissous",
+		"disuelta",
+		"sciolta",
+		"ontbonden",
+	},
+}
+
+// statuses is the index of all status markers.
+var statuses = newStatusIndex(statusMarkers)
+
+// statusIndex maps the keys of status markers to their status.
+type statusIndex struct {
+	keys      map[string]Status
+	maxTokens int
+}
+
+func newStatusIndex(markers map[Status][]string) statusIndex {
+	idx := statusIndex{keys: map[string]Status{}}
+	for status, texts := range markers {
+		for _, text := range texts {
+			idx.keys[cleanKey(text)] = status
+			idx.maxTokens = max(idx.maxTokens, len(strings.Fields(text)))
+		}
+	}
+	return idx
+}
+
+// StripStatus strips status markers like "i.L." or "(in liquidation)" from
+// the end of the full company name and then strips the legal form in front of
+// them like Strip.
+//
+// Markers that are abbreviations with up to two letters, e.g. "i.L." or
+// "i.G.", and single words, e.g. "dissolved", are only recognized directly
+// after a legal form or another marker, as they might be initials or part of
+// the name otherwise. If there are several markers, e.g.
+// "Example GmbH in Liquidation i.L.", then all of them are stripped and the
+// status of the first one is returned. If there is no status marker, then
+// StatusNone is returned together with the result of Strip.
+func (f LegalForms) StripStatus(fullName string) (string, string, Status) {
+	rest, status := stripStatus(f, fullName)
+	name, legalForm := strip(f, rest)
+	return name, legalForm, status
+}
+
+// StripStatus strips the status markers from the end of the full company name
+// and then strips the legal form in front of them like LegalForms.StripStatus.
+func (m *Matcher) StripStatus(fullName string) (string, string, Status) {
+	rest, status := stripStatus(m, fullName)
+	name, legalForm := m.strip(m, "", rest)
+	return name, legalForm, status
+}
+
+// statusBrackets are removed from the tokens of a status marker, e.g.
+// "(in liquidation)" or "[dissolved]".
+const statusBrackets = "()[]{}（）"
+
+// stripStatus removes the status markers from the end of the full company
+// name, e.g. both of "in Liquidation i.L.". The status of the first marker,
+// i.e. the one directly after the legal form, is returned. The first token is
+// never considered to be part of a marker.
+func stripStatus(idx index, fullName string) (string, Status) {
+	tokens := splitBrackets(tokenize(idx, fullName))
+	cleanTokens := cleanTokens(tokens)
+	end, status := len(tokens), StatusNone
+	for {
+		start, s := statusStart(idx, cleanTokens[:end])
+		if start == end {
+			break
+		}
+		end, status = start, s
+	}
+	if status == StatusNone {
+		return fullName, StatusNone
+	}
+	return trimSeparators(join(tokens[:end], fullName)), status
+}
+
+// statusStart returns the index of the first token of the longest status
+// marker at the end of the tokens and its status. Markers that need a legal
+// form are also accepted directly after another marker. If there is no marker,
+// then the number of tokens and StatusNone are returned.
+func statusStart(idx index, cleanTokens []string) (int, Status) {
+	for start := max(1, len(cleanTokens)-statuses.maxTokens); start < len(cleanTokens); start++ {
+		key := strings.Join(cleanTokens[start:], "")
+		key = strings.Map(func(r rune) rune {
+			if strings.ContainsRune(statusBrackets, r) {
+				return -1
+			}
+			return r
+		}, key)
+		status, ok := statuses.keys[key]
+		if !ok {
+			continue
+		}
+		if needsLegalForm(key, len(cleanTokens)-start) && suffixStart(idx, cleanTokens[:start], nil) == start {
+			if prev, _ := statusStart(idx, cleanTokens[:start]); prev == start {
+				continue
+			}
+		}
+		return start, status
+	}
+	return len(cleanTokens), StatusNone
+}
+
+// needsLegalForm checks if the status marker is only recognized directly after
+// a legal form. Abbreviations with up to two letters might be initials and
+// single words might be part of the name otherwise.
+func needsLegalForm(key string, tokens int) bool {
+	return tokens == 1 || utf8.RuneCountInString(key) <= 2
+}
+
+// splitBrackets splits tokens in front of an opening bracket that is glued to
+// the previous word, e.g. "Ltd.(in" into "Ltd." and "(in".
+func splitBrackets(tokens []token) []token {
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		pos := strings.IndexAny(t.text[1:], "([{（") + 1
+		if pos == 0 {
+			continue
+		}
+		tokens = slices.Insert(tokens, i+1, token{text: t.text[pos:], start: t.start + pos, end: t.end})
+		tokens[i] = token{text: t.text[:pos], start: t.start, end: t.start + pos}
+	}
+	return tokens
+}
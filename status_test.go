@@ -0,0 +1,64 @@
+package legalform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestStripStatus(t *testing.T) {
+	cases := []struct {
+		input               string
+		expectedCompanyName string
+		expectedLegalForm   string
+		expectedStatus      legalform.Status
+	}{
+		{"Example GmbH i.L.", "Example", "GmbH", legalform.StatusLiquidation},
+		{"Example GmbH i. L.", "Example", "GmbH", legalform.StatusLiquidation},
+		{"Example GmbH in Liquidation", "Example", "GmbH", legalform.StatusLiquidation},
+		{"Example Ltd (in liquidation)", "Example", "Ltd", legalform.StatusLiquidation},
+		{"Example Ltd [in liquidation]", "Example", "Ltd", legalform.StatusLiquidation},
+		{"Example Ltd.(in liquidation)", "Example", "Ltd.", legalform.StatusLiquidation},
+		{"Example Ltd (dissolved)", "Example", "Ltd", legalform.StatusDissolved},
+		{"Example Ltd - In Liquidation", "Example", "Ltd", legalform.StatusLiquidation},
+		{"Example SARL en liquidation", "Example", "SARL", legalform.StatusLiquidation},
+		{"Example S.r.l. in liquidazione", "Example", "S.r.l.", legalform.StatusLiquidation},
+		{"Example Sp. z o.o. w likwidacji", "Example", "Sp. z o.o.", legalform.StatusLiquidation},
+		{"Example GmbH i.G.", "Example", "GmbH", legalform.StatusFormation},
+		{"Example GmbH in Gründung", "Example", "GmbH", legalform.StatusFormation},
+		{"Example B.V. in oprichting", "Example", "B.V.", legalform.StatusFormation},
+		{"Example AG in Konkurs", "Example", "AG", legalform.StatusAdministration},
+		{"Example Limited (In Administration)", "Example", "Limited", legalform.StatusAdministration},
+		{"Example Ltd in receivership", "Example", "Ltd", legalform.StatusReceivership},
+		{"Example Ltd, dissolved", "Example", "Ltd", legalform.StatusDissolved},
+		{"Example in Liquidation", "Example", "", legalform.StatusLiquidation},
+		{"Example GmbH in Liquidation i.L.", "Example", "GmbH", legalform.StatusLiquidation},
+		{"Example Ltd (in liquidation) (dissolved)", "Example", "Ltd", legalform.StatusLiquidation},
+		{"Example GmbH i.G. in Liquidation", "Example", "GmbH", legalform.StatusFormation},
+		{"Example in Liquidation i.L.", "Example", "", legalform.StatusLiquidation},
+		{"Example GmbH", "Example", "GmbH", legalform.StatusNone},
+		{"Example i.L.", "Example i.L.", "", legalform.StatusNone},
+		{"Dissolved", "Dissolved", "", legalform.StatusNone},
+		{"Problem Solved dissolved", "Problem Solved dissolved", "", legalform.StatusNone},
+		{"", "", "", legalform.StatusNone},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			companyName, legalForm, status := legalform.Default.StripStatus(c.input)
+			assert.Equal(t, c.expectedCompanyName, companyName)
+			assert.Equal(t, c.expectedLegalForm, legalForm)
+			assert.Equal(t, c.expectedStatus, status)
+
+			companyName, legalForm, status = legalform.DefaultMatcher().StripStatus(c.input)
+			assert.Equal(t, c.expectedCompanyName, companyName)
+			assert.Equal(t, c.expectedLegalForm, legalForm)
+			assert.Equal(t, c.expectedStatus, status)
+		})
+	}
+
+	assert.Equal(t, "liquidation", legalform.StatusLiquidation.String())
+	assert.Equal(t, "none", legalform.Status(42).String())
+}
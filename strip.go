@@ -2,38 +2,13 @@ package legalform
 
 import (
 	"strings"
-
-	"github.com/tilotech/go-phonetics/diacrit"
+	"unicode/utf8"
 )
 
 // Strip strips the legal form from the end of the full company name and returns the plain
 // name as well as the legal form independently.
 func (f LegalForms) Strip(fullName string) (string, string) {
-	tokens := strings.Fields(fullName)
-
-	cleanTokens := make([]string, len(tokens))
-	normalizedTokens := make([]string, len(tokens))
-	currentTokenLength := 0
-	legalFormTokenLength := 0
-
-	for i, t := range tokens {
-		normalizedTokens[i] = diacrit.Normalize(t)
-	}
-
-	for i := len(normalizedTokens) - 1; i > 0; i-- {
-		currentTokenLength++
-		cleanTokens[i] = clean(normalizedTokens[i])
-		tokenSearch := strings.Join(cleanTokens[len(normalizedTokens)-currentTokenLength-legalFormTokenLength:], "")
-		if _, ok := f[tokenSearch]; ok {
-			legalFormTokenLength += currentTokenLength
-			currentTokenLength = 0
-		}
-	}
-
-	legalFormTokenStart := len(tokens) - legalFormTokenLength
-	company := strings.Join(tokens[0:legalFormTokenStart], " ")
-	legalForm := strings.Join(tokens[legalFormTokenStart:], " ")
-	return company, legalForm
+	return strip(f, fullName)
 }
 
 // StripMiddle strips the legal form from anywhere in the full company name and
@@ -42,57 +17,180 @@ func (f LegalForms) Strip(fullName string) (string, string) {
 // If the legal form was not at the end, then everything after the legal form
 // will be returned as the third response value.
 func (f LegalForms) StripMiddle(fullName string) (string, string, string) {
-	tokens := strings.Fields(fullName)
-	cleanTokens := make([]string, len(tokens))
+	return stripMiddle(f, fullName)
+}
+
+// StripPrefix strips the legal forms from the beginning and the end of the
+// full company name and returns the plain name as well as the leading and the
+// trailing legal form independently.
+//
+// This is useful for languages that put the legal form in front of the name,
+// e.g. "ООО Ромашка" or "PT Example Indonesia". Names with both, a leading and
+// a trailing legal form like "SC Example SRL" will return both of them. If no
+// legal form was found at either end, then the respective value is empty.
+//
+// Only legal forms of countries that write them in front of the name, e.g.
+// Russia, Vietnam, Indonesia, Romania, Korea or Japan, are stripped from the
+// beginning, so "Au Bon Pain" or "AG Example" are kept. Legal forms that are
+// not assigned to any country are stripped if their ambiguity is low.
+func (f LegalForms) StripPrefix(fullName string) (string, string, string) {
+	return stripPrefix(f, defaultCountries, fullName)
+}
+
+func strip(idx index, fullName string) (string, string) {
+	b := getTokens(idx, fullName)
+	defer b.release()
+	legalFormTokenStart := suffixStart(idx, b.cleanTokens, b.starts)
+	return join(b.tokens[0:legalFormTokenStart], fullName), join(b.tokens[legalFormTokenStart:], fullName)
+}
+
+func stripMiddle(idx index, fullName string) (string, string, string) {
+	b := getTokens(idx, fullName)
+	defer b.release()
+	start, end := findMiddle(idx, b.tokens, b.cleanTokens, b.starts)
+	return join(b.tokens[0:start], fullName), join(b.tokens[start:end], fullName), join(b.tokens[end:], fullName)
+}
 
-	for i := range tokens {
-		cleanTokens[i] = clean(diacrit.Normalize(tokens[i]))
+func stripPrefix(idx index, countries countryIndex, fullName string) (string, string, string) {
+	b := getTokens(idx, fullName)
+	defer b.release()
+	suffixStart := suffixStart(idx, b.cleanTokens, b.starts)
+	prefixEnd := prefixEnd(idx, countries, b.cleanTokens[:suffixStart], b.starts)
+	return join(b.tokens[prefixEnd:suffixStart], fullName), join(b.tokens[:prefixEnd], fullName), join(b.tokens[suffixStart:], fullName)
+}
+
+// suffixStart returns the index of the first token of the longest legal form
+// at the end of the tokens. The first token is never considered to be part of
+// the legal form.
+//
+// If no legal form was found, then the number of tokens is returned. The buf is
+// used to collect the candidates and may be nil.
+func suffixStart(idx index, cleanTokens []string, buf []int) int {
+	if len(cleanTokens) < 2 {
+		return len(cleanTokens)
 	}
+	starts := idx.suffixes(cleanTokens[1:], buf[:0])
+	if len(starts) == 0 {
+		return len(cleanTokens)
+	}
+	return starts[len(starts)-1] + 1
+}
 
-	for i := len(tokens) - 1; i > 0; i-- {
-		searchEndIdx := i + 1
-		obsoleteTokenLength := len(tokens) - searchEndIdx
-		currentTokenLength := 0
-		legalFormTokenLength := 0
-		for j := i; j > 0; j-- {
-			currentTokenLength++
-			searchStartIdx := len(tokens) - currentTokenLength - legalFormTokenLength - obsoleteTokenLength
-			tokenSearch := strings.Join(cleanTokens[searchStartIdx:searchEndIdx], "")
-			if _, ok := f[tokenSearch]; ok {
-				legalFormTokenLength += currentTokenLength
-				currentTokenLength = 0
-			}
+// prefixEnd returns the index after the last token of the longest legal form
+// at the start of the tokens that is written in front of the name according
+// to the countries, see countryIndex.leadsName. The last token is never
+// considered to be part of the legal form.
+//
+// If no legal form was found, then 0 is returned. The buf is used to collect
+// the candidates and may be nil.
+func prefixEnd(idx index, countries countryIndex, cleanTokens []string, buf []int) int {
+	if len(cleanTokens) < 2 {
+		return 0
+	}
+	ends := idx.prefixes(cleanTokens[:len(cleanTokens)-1], buf[:0])
+	for i := len(ends) - 1; i >= 0; i-- {
+		if countries.leadsName(strings.Join(cleanTokens[:ends[i]], "")) {
+			return ends[i]
 		}
+	}
+	return 0
+}
 
-		legalFormTokenStart := len(tokens) - legalFormTokenLength - obsoleteTokenLength
-		foundLegalForm := legalFormTokenStart <= i
-		if foundLegalForm {
-			legalForm := strings.Join(tokens[legalFormTokenStart:searchEndIdx], " ")
-			if len(legalForm) > 1 {
-				company := strings.Join(tokens[0:legalFormTokenStart], " ")
-				other := ""
-				if obsoleteTokenLength > 0 {
-					other = strings.Join(tokens[i+1:], " ")
-				}
-				return company, legalForm, other
+// findMiddle returns the token range of the legal form that is closest to the
+// end of the tokens.
+//
+// If no legal form was found, then the range starts and ends after the last
+// token. The buf is used to collect the candidates and may be nil.
+func findMiddle(idx index, tokens []token, cleanTokens []string, buf []int) (int, int) {
+	start, end := len(tokens), len(tokens)
+	ranges(idx, tokens, cleanTokens, buf, func(s, e int) bool {
+		start, end = s, e
+		return false
+	})
+	return start, end
+}
+
+// ranges calls yield for every token range that forms a legal form, starting
+// with the ranges closest to the end of the tokens and preferring longer
+// legal forms over shorter ones. The first token is never considered to be
+// part of a legal form. The buf is used to collect the candidates and may be
+// nil.
+func ranges(idx index, tokens []token, cleanTokens []string, buf []int, yield func(start, end int) bool) {
+	for searchEndIdx := len(tokens); searchEndIdx > 1; searchEndIdx-- {
+		starts := idx.suffixes(cleanTokens[1:searchEndIdx], buf[:0])
+		buf = starts
+		for i := len(starts) - 1; i >= 0; i-- {
+			j := starts[i] + 1
+			if searchEndIdx-j == 1 && len(tokens[j].text) <= 1 {
+				continue
+			}
+			if !yield(j, searchEndIdx) {
+				return
 			}
 		}
 	}
-	return strings.Join(tokens, " "), "", ""
 }
 
 func clean(s string) string {
 	var sb strings.Builder
 	sb.Grow(len(s))
 
-	for _, r := range s {
+	skip := 0
+	for i, r := range s {
+		if i < skip {
+			continue
+		}
 		switch r {
-		case '.', '-', '/', '"', '’', '(', ')', '&', '\'', ',', ':', ' ':
+		case '(', '（':
+			if form, size := enclosedOnlyForm(s[i:]); size > 0 {
+				sb.WriteRune(form)
+				skip = i + size
+			}
+		case '.', '-', '/', '"', '’', ')', '）', '&', '\'', ',', ':', ' ':
 			continue
 		default:
+			if form, ok := enclosedForms[r]; ok {
+				r = form
+			}
 			sb.WriteRune(r)
 		}
 	}
 
 	return strings.ToLower(sb.String())
 }
+
+// enclosedForms maps enclosed characters that represent a legal form to their
+// plain character, e.g. "㈱" is a short form of "(株)".
+var enclosedForms = map[rune]rune{
+	'㈱': '株',
+	'㊑': '株',
+	'㈲': '有',
+	'㊒': '有',
+	'㈴': '名',
+	'㊔': '名',
+	'㈾': '資',
+	'㊮': '資',
+}
+
+// enclosedOnlyForms maps bracketed legal forms that are also common syllables
+// to their enclosed character, e.g. "(주)" to "㈜". They are only recognized
+// when enclosed, hence a plain "주" is never stripped.
+var enclosedOnlyForms = map[string]rune{
+	"(주)": '㈜',
+	"（주）": '㈜',
+}
+
+// enclosedOnlyForm returns the enclosed character of the bracketed legal form
+// at the start of s and the number of bytes it occupies, or 0 for both if s
+// does not start with one of the enclosedOnlyForms.
+func enclosedOnlyForm(s string) (rune, int) {
+	end := strings.IndexAny(s, ")）")
+	if end < 0 {
+		return 0, 0
+	}
+	_, size := utf8.DecodeRuneInString(s[end:])
+	if form, ok := enclosedOnlyForms[s[:end+size]]; ok {
+		return form, end + size
+	}
+	return 0, 0
+}
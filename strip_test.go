@@ -29,6 +29,26 @@ func TestStrip(t *testing.T) {
 			expectedCompanyName: "Example",
 			expectedLegalForm:   "GmbH & Co. KG",
 		},
+		{
+			input:               "Example s.r.o.",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "s.r.o.",
+		},
+		{
+			input:               "Example SASU",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "SASU",
+		},
+		{
+			input:               "Example EURL",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "EURL",
+		},
+		{
+			input:               "Example, Lda.",
+			expectedCompanyName: "Example,",
+			expectedLegalForm:   "Lda.",
+		},
 		{
 			input:               "llc Oy AG",
 			expectedCompanyName: "llc Oy",
@@ -193,6 +213,321 @@ func TestStripMiddle(t *testing.T) {
 	}
 }
 
+func TestStripPrefix(t *testing.T) {
+	cases := []struct {
+		input               string
+		expectedCompanyName string
+		expectedPrefix      string
+		expectedSuffix      string
+	}{
+		{
+			input:               "ООО Ромашка",
+			expectedCompanyName: "Ромашка",
+			expectedPrefix:      "ООО",
+		},
+		{
+			input:               "PT Example Indonesia",
+			expectedCompanyName: "Example Indonesia",
+			expectedPrefix:      "PT",
+		},
+		{
+			input:               "CV Example",
+			expectedCompanyName: "Example",
+			expectedPrefix:      "CV",
+		},
+		{
+			input:               "Công ty TNHH Example",
+			expectedCompanyName: "Example",
+			expectedPrefix:      "Công ty TNHH",
+		},
+		{
+			input:               "SC Example SRL",
+			expectedCompanyName: "Example",
+			expectedPrefix:      "SC",
+			expectedSuffix:      "SRL",
+		},
+		{
+			input:               "Example GmbH & Co. KG",
+			expectedCompanyName: "Example",
+			expectedSuffix:      "GmbH & Co. KG",
+		},
+		{
+			input:               "LLC AG",
+			expectedCompanyName: "LLC",
+			expectedSuffix:      "AG",
+		},
+		{
+			input:               "Example",
+			expectedCompanyName: "Example",
+		},
+		{
+			input:               "LLC",
+			expectedCompanyName: "LLC",
+		},
+		{
+			input:               "Au Bon Pain",
+			expectedCompanyName: "Au Bon Pain",
+		},
+		{
+			input:               "Am Markt Bau GmbH",
+			expectedCompanyName: "Am Markt Bau",
+			expectedSuffix:      "GmbH",
+		},
+		{
+			input:               "Co Example",
+			expectedCompanyName: "Co Example",
+		},
+		{
+			input:               "Bo Concept",
+			expectedCompanyName: "Bo Concept",
+		},
+		{
+			input:               "AG Example",
+			expectedCompanyName: "AG Example",
+		},
+		{
+			input:               "",
+			expectedCompanyName: "",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			actualCompany, actualPrefix, actualSuffix := legalform.Default.StripPrefix(c.input)
+			assert.Equal(t, c.expectedCompanyName, actualCompany)
+			assert.Equal(t, c.expectedPrefix, actualPrefix)
+			assert.Equal(t, c.expectedSuffix, actualSuffix)
+		})
+	}
+}
+
+func TestStripUnspacedScripts(t *testing.T) {
+	cases := []struct {
+		input               string
+		expectedCompanyName string
+		expectedPrefix      string
+		expectedSuffix      string
+	}{
+		{
+			input:               "株式会社トヨタ",
+			expectedCompanyName: "トヨタ",
+			expectedPrefix:      "株式会社",
+		},
+		{
+			input:               "トヨタ株式会社",
+			expectedCompanyName: "トヨタ",
+			expectedSuffix:      "株式会社",
+		},
+		{
+			input:               "华为技术有限公司",
+			expectedCompanyName: "华为技术",
+			expectedSuffix:      "有限公司",
+		},
+		{
+			input:               "(주)삼성",
+			expectedCompanyName: "삼성",
+			expectedPrefix:      "(주)",
+		},
+		{
+			input:               "삼성전자㈜",
+			expectedCompanyName: "삼성전자",
+			expectedSuffix:      "㈜",
+		},
+		{
+			input:               "삼성전자 (주)",
+			expectedCompanyName: "삼성전자",
+			expectedSuffix:      "(주)",
+		},
+		{
+			input:               "삼성전자 （주）",
+			expectedCompanyName: "삼성전자",
+			expectedSuffix:      "（주）",
+		},
+		{
+			input:               "삼성전자 (주식회사)",
+			expectedCompanyName: "삼성전자",
+			expectedSuffix:      "(주식회사)",
+		},
+		{
+			input:               "삼성전자 (주",
+			expectedCompanyName: "삼성전자 (주",
+		},
+		{
+			input:               "Foo 주",
+			expectedCompanyName: "Foo 주",
+		},
+		{
+			input:               "주 삼성전자",
+			expectedCompanyName: "주 삼성전자",
+		},
+		{
+			input:               "㈱トヨタ",
+			expectedCompanyName: "トヨタ",
+			expectedPrefix:      "㈱",
+		},
+		{
+			input:               "トヨタ（株）",
+			expectedCompanyName: "トヨタ",
+			expectedSuffix:      "（株）",
+		},
+		{
+			input:               "株式会社トヨタ 東京有限会社",
+			expectedCompanyName: "トヨタ 東京",
+			expectedPrefix:      "株式会社",
+			expectedSuffix:      "有限会社",
+		},
+		{
+			input:               "日本名",
+			expectedCompanyName: "日本名",
+		},
+		{
+			input:               "株式会社",
+			expectedCompanyName: "株式会社",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			actualCompany, actualPrefix, actualSuffix := legalform.Default.StripPrefix(c.input)
+			assert.Equal(t, c.expectedCompanyName, actualCompany)
+			assert.Equal(t, c.expectedPrefix, actualPrefix)
+			assert.Equal(t, c.expectedSuffix, actualSuffix)
+		})
+	}
+}
+
+func TestStripAttachedLegalForms(t *testing.T) {
+	cases := []struct {
+		input               string
+		expectedCompanyName string
+		expectedLegalForm   string
+		expectedRemainder   string
+	}{
+		{
+			input:               "Example-GmbH",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "GmbH",
+		},
+		{
+			input:               "Example,Inc.",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "Inc.",
+		},
+		{
+			input:               "Example/AG",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "AG",
+		},
+		{
+			input:               "Example-AG",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "AG",
+		},
+		{
+			input:               "Example,SA",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "SA",
+		},
+		{
+			input:               "Example,S.A.",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "S.A.",
+		},
+		{
+			input:               "Example/Ltd",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "Ltd",
+		},
+		{
+			input:               "Foo-Bar-GmbH",
+			expectedCompanyName: "Foo-Bar",
+			expectedLegalForm:   "GmbH",
+		},
+		{
+			input:               "Example-GmbH & Co. KG",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "GmbH & Co. KG",
+		},
+		{
+			input:               "Example-AG Some Street",
+			expectedCompanyName: "Example",
+			expectedLegalForm:   "AG",
+			expectedRemainder:   "Some Street",
+		},
+		{
+			input:               "Example/Ag",
+			expectedCompanyName: "Example/Ag",
+		},
+		{
+			input:               "PRO-AM",
+			expectedCompanyName: "PRO-AM",
+		},
+		{
+			input:               "Hi-Fi",
+			expectedCompanyName: "Hi-Fi",
+		},
+		{
+			input:               "Pro-Am",
+			expectedCompanyName: "Pro-Am",
+		},
+		{
+			input:               "Duo-Eu",
+			expectedCompanyName: "Duo-Eu",
+		},
+		{
+			input:               "Bio-Se",
+			expectedCompanyName: "Bio-Se",
+		},
+		{
+			input:               "Eco-Sa",
+			expectedCompanyName: "Eco-Sa",
+		},
+		{
+			input:               "Info-Ba",
+			expectedCompanyName: "Info-Ba",
+		},
+		{
+			input:               "Example Hi-Fi",
+			expectedCompanyName: "Example Hi-Fi",
+		},
+		{
+			input:               "Coca-Cola",
+			expectedCompanyName: "Coca-Cola",
+		},
+		{
+			input:               "Example-A",
+			expectedCompanyName: "Example-A",
+		},
+		{
+			input:               "-GmbH",
+			expectedCompanyName: "-GmbH",
+		},
+		{
+			input:               "ExampleGmbH",
+			expectedCompanyName: "ExampleGmbH",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			actualCompany, actualLegalForm, actualRemainder := legalform.Default.StripMiddle(c.input)
+			assert.Equal(t, c.expectedCompanyName, actualCompany)
+			assert.Equal(t, c.expectedLegalForm, actualLegalForm)
+			assert.Equal(t, c.expectedRemainder, actualRemainder)
+		})
+	}
+}
+
+func TestStripKeepsUnspacedNames(t *testing.T) {
+	actualCompany, actualLegalForm := legalform.Default.Strip("株式会社トヨタ")
+	assert.Equal(t, "株式会社トヨタ", actualCompany)
+	assert.Equal(t, "", actualLegalForm)
+
+	actualCompany, actualLegalForm = legalform.Default.Strip("トヨタ自動車株式会社")
+	assert.Equal(t, "トヨタ自動車", actualCompany)
+	assert.Equal(t, "株式会社", actualLegalForm)
+}
+
 func TestStripThenAlias(t *testing.T) {
 	cases := []struct {
 		input                  string
@@ -229,6 +564,20 @@ func TestStripThenAlias(t *testing.T) {
 			expectedLegalForm:      "Private Ltd",
 			expectedAliasLegalForm: "pvtltd",
 		},
+		{
+			input:                  "삼성전자(주)",
+			country:                "KR",
+			expectedCompanyName:    "삼성전자",
+			expectedLegalForm:      "(주)",
+			expectedAliasLegalForm: "jsc",
+		},
+		{
+			input:                  "トヨタ㈱",
+			country:                "JP",
+			expectedCompanyName:    "トヨタ",
+			expectedLegalForm:      "㈱",
+			expectedAliasLegalForm: "kk",
+		},
 		{
 			input:                  "The example Corp. kk",
 			country:                "JP",
@@ -0,0 +1,370 @@
+package legalform
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tilotech/go-phonetics/diacrit"
+)
+
+// token represents a part of the full company name together with its byte
+// offsets within the full company name.
+type token struct {
+	text  string
+	start int
+	end   int
+}
+
+// tokenize splits the full company name into its tokens.
+//
+// Tokens are separated by white spaces. Scripts that do not use white spaces
+// between words, e.g. Chinese, Japanese or Korean, are additionally segmented
+// when a legal form is glued to the start or the end of such a token. The same
+// applies to legal forms that are attached to the end of a token by
+// punctuation, e.g. "Example-GmbH", or, if enabled, by a change of the case,
+// e.g. "ExampleGmbH".
+func tokenize(idx index, fullName string) []token {
+	return appendTokens(make([]token, 0, 8), idx, fullName)
+}
+
+// appendTokens appends the tokens of the full company name to tokens like
+// tokenize.
+func appendTokens(tokens []token, idx index, fullName string) []token {
+	s := segmenter{idx: idx}
+	if cs, ok := idx.(caseSplitter); ok {
+		s.splitCase = cs.splitsCase()
+	}
+
+	start := -1
+	for i, r := range fullName {
+		switch {
+		case unicode.IsSpace(r) && start >= 0:
+			tokens = s.append(tokens, token{text: fullName[start:i], start: start, end: i})
+			start = -1
+		case !unicode.IsSpace(r) && start < 0:
+			start = i
+		}
+	}
+	if start >= 0 {
+		tokens = s.append(tokens, token{text: fullName[start:], start: start, end: len(fullName)})
+	}
+	return tokens
+}
+
+// caseSplitter is implemented by indexes that split tokens at case changes.
+type caseSplitter interface {
+	splitsCase() bool
+}
+
+// segmenter splits tokens that contain a legal form that is not separated by
+// a white space.
+type segmenter struct {
+	idx       index
+	splitCase bool
+}
+
+// append appends the token to the tokens. If the token ends with a legal form
+// that is attached by punctuation or a case change, then the legal form is
+// appended as a separate token. The punctuation itself is not part of any
+// token.
+func (s segmenter) append(tokens []token, t token) []token {
+	if end, start := s.attachedSuffix(t.text); start > 0 {
+		tokens = s.append(tokens, token{text: t.text[:end], start: t.start, end: t.start + end})
+		return append(tokens, token{text: t.text[start:], start: t.start + start, end: t.end})
+	}
+	return appendSegmented(s.idx, tokens, t)
+}
+
+// attachedSuffix searches the longest legal form that is attached to the end
+// of the text. It returns the byte offset at which the text before the legal
+// form ends and the byte offset at which the legal form starts, or 0 for both
+// if there is no such legal form.
+func (s segmenter) attachedSuffix(text string) (int, int) {
+	if !s.splitCase && !strings.ContainsAny(text, attachingPunctuation) {
+		return 0, 0
+	}
+	var prev rune
+	for i, r := range text {
+		switch {
+		case strings.ContainsRune(attachingPunctuation, r):
+			start := i + utf8.RuneLen(r)
+			if isAttachedLegalForm(s.idx, text[start:], text[:i]) {
+				return i, start
+			}
+		case s.splitCase && unicode.IsLower(prev) && unicode.IsUpper(r):
+			if isAttachedLegalForm(s.idx, text[i:], text[:i]) {
+				return i, i
+			}
+		}
+		prev = r
+	}
+	return 0, 0
+}
+
+// attachingPunctuation are the characters that can attach a legal form to the
+// name without a white space, e.g. "Example-GmbH", "Example,Inc." or
+// "Example/AG".
+const attachingPunctuation = "-/,"
+
+// isAttachedLegalForm checks if the segment is a legal form that can be split
+// from the remaining part of the token. Legal forms consisting of a single
+// character are never split, e.g. "A" from "Example-A". Short or ambiguous
+// legal forms are only split if they are written in upper case or with dots,
+// e.g. "AG" from "Example-AG", but not "Fi" from "Hi-Fi".
+func isAttachedLegalForm(idx index, segment, remainder string) bool {
+	key := cleanToken(segment)
+	if utf8.RuneCountInString(key) <= 1 || !idx.has(key) || cleanToken(remainder) == "" {
+		return false
+	}
+	return ambiguity(key) == AmbiguityLow || hasLegalFormEvidence(segment, remainder)
+}
+
+// appendSegmented appends the token to the tokens. If the token contains a
+// legal form glued to its start or its end, then the token is split and
+// each part is appended individually.
+func appendSegmented(idx index, tokens []token, t token) []token {
+	if !strings.ContainsFunc(t.text, isUnspaced) {
+		return append(tokens, t)
+	}
+
+	if end := gluedPrefix(idx, t.text); end > 0 {
+		tokens = append(tokens, token{text: t.text[:end], start: t.start, end: t.start + end})
+		t = token{text: t.text[end:], start: t.start + end, end: t.end}
+	}
+
+	if start := gluedSuffix(idx, t.text); start > 0 {
+		return append(tokens,
+			token{text: t.text[:start], start: t.start, end: t.start + start},
+			token{text: t.text[start:], start: t.start + start, end: t.end},
+		)
+	}
+	return append(tokens, t)
+}
+
+// gluedPrefix returns the byte offset at which the longest legal form at the
+// start of s ends or 0 if there is no such legal form.
+func gluedPrefix(idx index, s string) int {
+	for end := len(s); end > 0; {
+		_, size := utf8.DecodeLastRuneInString(s[:end])
+		end -= size
+		if end > 0 && isGluedLegalForm(idx, s[:end], s[end:]) {
+			return end
+		}
+	}
+	return 0
+}
+
+// gluedSuffix returns the byte offset at which the longest legal form at the
+// end of s starts or 0 if there is no such legal form.
+func gluedSuffix(idx index, s string) int {
+	for start := 0; start < len(s); {
+		_, size := utf8.DecodeRuneInString(s[start:])
+		start += size
+		if start < len(s) && isGluedLegalForm(idx, s[start:], s[:start]) {
+			return start
+		}
+	}
+	return 0
+}
+
+// isGluedLegalForm checks if the segment is a legal form that can be split
+// from the remaining part of the token.
+//
+// Legal forms consisting of a single character, e.g. "株" or "㈜", are only
+// accepted if they are enclosed in brackets like "(株)" or written as a single
+// enclosed character like "㈱". Otherwise too many regular names would lose
+// their first or last character.
+func isGluedLegalForm(idx index, segment, remainder string) bool {
+	if !strings.ContainsFunc(segment, isUnspaced) || cleanToken(remainder) == "" {
+		return false
+	}
+	key := cleanToken(segment)
+	if !idx.has(key) {
+		return false
+	}
+	return utf8.RuneCountInString(key) > 1 || isEnclosed(segment)
+}
+
+// isUnspaced checks if the rune belongs to a script that does not use white
+// spaces between words.
+func isUnspaced(r rune) bool {
+	if r < utf8.RuneSelf {
+		return false
+	}
+	return unicode.Is(enclosedCJK, r) || unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// enclosedCJK are the enclosed CJK letters, e.g. "㈱" or "㈜".
+var enclosedCJK = &unicode.RangeTable{
+	R16: []unicode.Range16{{Lo: 0x3200, Hi: 0x32ff, Stride: 1}},
+}
+
+// isEnclosed checks if s is a single enclosed character or if it is wrapped
+// in brackets.
+func isEnclosed(s string) bool {
+	first, size := utf8.DecodeRuneInString(s)
+	if size == len(s) {
+		return unicode.Is(enclosedCJK, first)
+	}
+	last, _ := utf8.DecodeLastRuneInString(s)
+	return (first == '(' || first == '（') && (last == ')' || last == '）')
+}
+
+// join joins the tokens using a single white space between them. Tokens that
+// were glued together in the full company name will be joined without a white
+// space and tokens that were attached by punctuation keep their punctuation.
+func join(tokens []token, fullName string) string {
+	if len(tokens) == 0 {
+		return ""
+	}
+	if isJoined(tokens, fullName) {
+		return fullName[tokens[0].start:tokens[len(tokens)-1].end]
+	}
+
+	var sb strings.Builder
+	for i, t := range tokens {
+		if i > 0 {
+			if gap := fullName[tokens[i-1].end:t.start]; isAttaching(gap) {
+				sb.WriteString(gap)
+			} else {
+				sb.WriteByte(' ')
+			}
+		}
+		sb.WriteString(t.text)
+	}
+	return sb.String()
+}
+
+// isJoined checks if the tokens are already separated by a single white space
+// or by punctuation within the full company name, hence joining them would not
+// change anything.
+func isJoined(tokens []token, fullName string) bool {
+	for i := 1; i < len(tokens); i++ {
+		gap := fullName[tokens[i-1].end:tokens[i].start]
+		if gap != " " && !isAttaching(gap) {
+			return false
+		}
+	}
+	return true
+}
+
+// isAttaching checks if the gap between two tokens does not contain any white
+// spaces, i.e. the tokens were glued together or attached by punctuation.
+func isAttaching(gap string) bool {
+	return !strings.ContainsFunc(gap, unicode.IsSpace)
+}
+
+// tokenBuffer holds the tokens and the clean tokens of a full company name.
+// Buffers are reused via tokenBuffers to avoid allocations on every call.
+type tokenBuffer struct {
+	tokens      []token
+	cleanTokens []string
+	ends        []int
+	// starts is used to collect the candidates of legal forms.
+	starts []int
+}
+
+var tokenBuffers = sync.Pool{New: func() any {
+	return &tokenBuffer{starts: make([]int, 0, 8)}
+}}
+
+// getTokens tokenizes the full company name into a buffer from tokenBuffers.
+// The buffer must be released once neither its tokens nor its clean tokens are
+// used anymore.
+func getTokens(idx index, fullName string) *tokenBuffer {
+	b := tokenBuffers.Get().(*tokenBuffer)
+	b.tokens = appendTokens(b.tokens[:0], idx, fullName)
+	b.cleanTokens, b.ends = appendCleanTokens(b.cleanTokens[:0], b.ends[:0], b.tokens)
+	return b
+}
+
+// release returns the buffer to tokenBuffers.
+func (b *tokenBuffer) release() {
+	tokenBuffers.Put(b)
+}
+
+// cleanTokens returns the cleaned and normalized text of each token.
+func cleanTokens(tokens []token) []string {
+	cleanTokens, _ := appendCleanTokens(make([]string, 0, len(tokens)), make([]int, 0, len(tokens)), tokens)
+	return cleanTokens
+}
+
+// appendCleanTokens appends the cleaned and normalized text of each token to
+// cleanTokens. The ends are used as buffer for the offsets of the tokens.
+//
+// The cleaned texts share a single underlying string to keep the number of
+// allocations low.
+func appendCleanTokens(cleanTokens []string, ends []int, tokens []token) ([]string, []int) {
+	var sb strings.Builder
+	size := 0
+	for _, t := range tokens {
+		size += len(t.text)
+	}
+	sb.Grow(size)
+
+	for _, t := range tokens {
+		writeCleanToken(&sb, t.text)
+		ends = append(ends, sb.Len())
+	}
+
+	cleaned := sb.String()
+	start := 0
+	for _, end := range ends {
+		cleanTokens = append(cleanTokens, cleaned[start:end])
+		start = end
+	}
+	return cleanTokens, ends
+}
+
+func cleanToken(s string) string {
+	if isCleanASCII(s) {
+		return s
+	}
+	return clean(diacrit.Normalize(s))
+}
+
+// writeCleanToken writes the cleaned and normalized text of s to sb. ASCII
+// texts are cleaned directly, because their normalization does not change
+// anything.
+func writeCleanToken(sb *strings.Builder, s string) {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			sb.WriteString(cleanToken(s))
+			return
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case isRemovedASCII(c):
+		case 'A' <= c && c <= 'Z':
+			sb.WriteByte(c + 'a' - 'A')
+		default:
+			sb.WriteByte(c)
+		}
+	}
+}
+
+// isCleanASCII checks if s only consists of ASCII characters that would not be
+// changed by cleaning.
+func isCleanASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c >= utf8.RuneSelf || isRemovedASCII(c) || 'A' <= c && c <= 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isRemovedASCII checks if c is one of the ASCII characters that are removed
+// by clean.
+func isRemovedASCII(c byte) bool {
+	return strings.IndexByte(".-/\"()&',: ", c) >= 0
+}
+
+// cleanKey returns the cleaned and normalized text without any white spaces,
+// i.e. the key as it would be looked up in the legal forms.
+func cleanKey(s string) string {
+	return strings.Join(strings.Fields(cleanToken(s)), "")
+}
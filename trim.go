@@ -0,0 +1,178 @@
+package legalform
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// danglingSeparators are removed from the start and the end of a name.
+const danglingSeparators = ",;:-–—/·"
+
+// spacedSeparators are only removed from the start and the end of a name if
+// they are separated from it by a white space, e.g. "Example &", but not
+// "AT&".
+const spacedSeparators = "&"
+
+// apostrophes are closing quotes that are also used as apostrophes, e.g. in
+// "McDonald’s" or "McDonald's". They are kept, even if they were never opened,
+// and only open a quote at the start of a word.
+const apostrophes = "’'"
+
+// bracketPairs are the opening and closing brackets and quotes that must be
+// balanced within a name.
+//
+// Some quotes open in one language and close in another one, e.g. "“" opens
+// in English, but closes in German after "„". Hence a character may appear as
+// opening and as closing character.
+var bracketPairs = [][2]rune{
+	{'(', ')'},
+	{'[', ']'},
+	{'{', '}'},
+	{'（', '）'},
+	{'"', '"'},
+	{'\'', '\''},
+	{'«', '»'},
+	{'»', '«'},
+	{'„', '“'},
+	{'„', '”'},
+	{'“', '”'},
+	{'”', '”'},
+	{'‚', '‘'},
+	{'‘', '’'},
+	{'‹', '›'},
+	{'›', '‹'},
+	{'「', '」'},
+	{'『', '』'},
+	{'《', '》'},
+}
+
+// TrimName removes dangling separators, unbalanced brackets and quotes as well
+// as brackets and quotes wrapping the whole name.
+//
+// Stripping the legal form may leave parts of the punctuation that belonged to
+// it, e.g. "Example," for "Example, Inc." or "Example GmbH (" for
+// "Example GmbH (i.L.)". Punctuation within the name is kept as long as it is
+// balanced, e.g. "Example (Deutschland)" or "«Example» Holding".
+func TrimName(name string) string {
+	for {
+		trimmed := trimSeparators(name)
+		trimmed = removeUnbalanced(trimmed)
+		trimmed = trimWrapping(trimSeparators(trimmed))
+		if trimmed == name {
+			return name
+		}
+		name = trimmed
+	}
+}
+
+// trimSeparators removes white spaces and dangling separators from both ends.
+func trimSeparators(name string) string {
+	for {
+		name = strings.TrimFunc(name, func(r rune) bool {
+			return unicode.IsSpace(r) || strings.ContainsRune(danglingSeparators, r)
+		})
+		first, firstSize := utf8.DecodeRuneInString(name)
+		last, lastSize := utf8.DecodeLastRuneInString(name)
+		switch {
+		case strings.ContainsRune(spacedSeparators, first) && isSpaceAfter(name, firstSize):
+			name = name[firstSize:]
+		case strings.ContainsRune(spacedSeparators, last) && isSpaceBefore(name, len(name)-lastSize):
+			name = name[:len(name)-lastSize]
+		default:
+			return name
+		}
+	}
+}
+
+// removeUnbalanced removes opening brackets or quotes that are never closed
+// and closing ones that were never opened. If such a bracket stood on its own,
+// then the white space after it is removed as well.
+func removeUnbalanced(name string) string {
+	unbalanced, _ := balance(name)
+	if len(unbalanced) == 0 {
+		return name
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(name))
+	skipSpace := false
+	for i, r := range name {
+		switch {
+		case slices.Contains(unbalanced, i):
+			skipSpace = i == 0 || isSpaceBefore(name, i)
+		case skipSpace && unicode.IsSpace(r):
+			skipSpace = false
+		default:
+			skipSpace = false
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// trimWrapping removes the bracket or quote pair that wraps the whole name.
+func trimWrapping(name string) string {
+	_, end := balance(name)
+	_, size := utf8.DecodeLastRuneInString(name)
+	if end <= 0 || end != len(name)-size {
+		return name
+	}
+	_, firstSize := utf8.DecodeRuneInString(name)
+	return name[firstSize:end]
+}
+
+// balance returns the byte offsets of all unbalanced brackets and quotes as
+// well as the byte offset of the bracket or quote that closes the one at the
+// start of the name. If the name does not start with a bracket or quote that
+// is closed, then -1 is returned as the latter.
+func balance(name string) ([]int, int) {
+	type opening struct {
+		r   rune
+		pos int
+	}
+	var stack []opening
+	var unbalanced []int
+	end := -1
+	for i, r := range name {
+		switch {
+		case len(stack) > 0 && closes(stack[len(stack)-1].r, r):
+			if stack[len(stack)-1].pos == 0 {
+				end = i
+			}
+			stack = stack[:len(stack)-1]
+		case isOpening(r) && (!strings.ContainsRune(apostrophes, r) || i == 0 || isSpaceBefore(name, i)):
+			stack = append(stack, opening{r: r, pos: i})
+		case isClosing(r) && !strings.ContainsRune(apostrophes, r):
+			unbalanced = append(unbalanced, i)
+		}
+	}
+	for _, o := range stack {
+		unbalanced = append(unbalanced, o.pos)
+	}
+	slices.Sort(unbalanced)
+	return unbalanced, end
+}
+
+func closes(open, r rune) bool {
+	return slices.Contains(bracketPairs, [2]rune{open, r})
+}
+
+func isOpening(r rune) bool {
+	return slices.ContainsFunc(bracketPairs, func(p [2]rune) bool { return p[0] == r })
+}
+
+func isClosing(r rune) bool {
+	return slices.ContainsFunc(bracketPairs, func(p [2]rune) bool { return p[1] == r })
+}
+
+func isSpaceBefore(s string, i int) bool {
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return unicode.IsSpace(r)
+}
+
+func isSpaceAfter(s string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return unicode.IsSpace(r)
+}
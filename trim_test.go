@@ -0,0 +1,110 @@
+package legalform_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestTrimName(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"Example", "Example"},
+		{"Example,", "Example"},
+		{"Example -", "Example"},
+		{"Example –", "Example"},
+		{"Example /", "Example"},
+		{"Example &", "Example"},
+		{"- Example", "Example"},
+		{"Example GmbH (", "Example GmbH"},
+		{"Example GmbH (,", "Example GmbH"},
+		{"Example )", "Example"},
+		{"Example (Berlin", "Example Berlin"},
+		{"Example ( Berlin", "Example Berlin"},
+		{"Example (Deutschland)", "Example (Deutschland)"},
+		{"(Example)", "Example"},
+		{"(Example) (Deutschland)", "(Example) (Deutschland)"},
+		{"Example Co.", "Example Co."},
+		{"McDonald's", "McDonald's"},
+		{"McDonald’s", "McDonald’s"},
+		{"Example & Sons", "Example & Sons"},
+		{"& Example", "Example"},
+		{"AT&", "AT&"},
+		{"Notepad++", "Notepad++"},
+		{"A+", "A+"},
+		{"Kids' Corner", "Kids' Corner"},
+		{"A-B", "A-B"},
+		// English
+		{"\"Example\"", "Example"},
+		{"“Example”", "Example"},
+		{"‘Example’", "Example"},
+		{"'Example'", "Example"},
+		{"'Example", "Example"},
+		{"'Example' Holding", "'Example' Holding"},
+		{"\"Example", "Example"},
+		// German
+		{"„Example“", "Example"},
+		{"‚Example‘", "Example"},
+		{"»Example«", "Example"},
+		{"„Example“ Holding", "„Example“ Holding"},
+		{"„Example", "Example"},
+		// Polish, Hungarian, Romanian
+		{"„Example”", "Example"},
+		// Swedish, Finnish
+		{"”Example”", "Example"},
+		// French
+		{"« Example »", "Example"},
+		{"‹Example›", "Example"},
+		// Swiss, Russian, Spanish
+		{"«Example»", "Example"},
+		{"«Example» Holding", "«Example» Holding"},
+		{"«Ромашка", "Ромашка"},
+		// Chinese, Japanese
+		{"「トヨタ」", "トヨタ"},
+		{"『トヨタ』", "トヨタ"},
+		{"《华为》", "华为"},
+		{"（トヨタ", "トヨタ"},
+		{"", ""},
+		{"(", ""},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			assert.Equal(t, c.expected, legalform.TrimName(c.input))
+		})
+	}
+}
+
+func TestMatcherWithNameTrimming(t *testing.T) {
+	matcher := legalform.NewMatcher(legalform.Default, legalform.WithNameTrimming())
+
+	name, legalForm := matcher.Strip("Example, Inc.")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "Inc.", legalForm)
+
+	name, legalForm, remainder := matcher.StripMiddle("„Example“ GmbH (in Liquidation)")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "GmbH", legalForm)
+	assert.Equal(t, "(in Liquidation)", remainder)
+
+	name, prefix, suffix := matcher.StripPrefix("ООО «Ромашка»")
+	assert.Equal(t, "Ромашка", name)
+	assert.Equal(t, "ООО", prefix)
+	assert.Equal(t, "", suffix)
+
+	name, legalForm = matcher.StripForCountry("DE", "Example, GmbH")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "GmbH", legalForm)
+
+	results, err := matcher.StripBatch(context.Background(), []legalform.Record{{FullName: "Example, Inc."}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Example", results[0].Name)
+
+	name, _ = legalform.DefaultMatcher().Strip("Example, Inc.")
+	assert.Equal(t, "Example,", name)
+}
@@ -0,0 +1,82 @@
+package legalform
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// NewLegalForms creates a new instance from the provided legal forms.
+//
+// The legal forms are cleaned the same way as they are when stripping, hence
+// they can be provided as they would appear in a company name, e.g. "S.A." or
+// "GmbH". Legal forms that are empty after cleaning are ignored.
+func NewLegalForms(forms ...string) LegalForms {
+	f := LegalForms{}
+	for _, form := range forms {
+		if key := cleanKey(form); key != "" {
+			f[key] = struct{}{}
+		}
+	}
+	return f
+}
+
+// ValidationError describes the keys of a LegalForms instance that were not
+// cleaned and hence will never match.
+type ValidationError struct {
+	// Unmatchable contains the keys that will never match.
+	Unmatchable []string
+	// Duplicates contains the unmatchable keys whose cleaned version is also a
+	// key.
+	Duplicates []string
+	// Collisions contains groups of unmatchable keys that result in the same
+	// cleaned key, which itself is not a key.
+	Collisions [][]string
+}
+
+// Error returns a summary of the invalid keys.
+func (e *ValidationError) Error() string {
+	msg := fmt.Sprintf("%v legal forms will never match: %v", len(e.Unmatchable), strings.Join(e.Unmatchable, ", "))
+	if len(e.Duplicates) > 0 {
+		msg += fmt.Sprintf("; duplicates: %v", strings.Join(e.Duplicates, ", "))
+	}
+	for _, collision := range e.Collisions {
+		msg += fmt.Sprintf("; collision: %v", strings.Join(collision, ", "))
+	}
+	return msg
+}
+
+// Validate checks whether all keys are cleaned the same way as they are when
+// stripping.
+//
+// If there are any keys that will never match, a *ValidationError is returned.
+// Use NewLegalForms to create an instance with cleaned keys.
+func (f LegalForms) Validate() error {
+	err := &ValidationError{}
+	cleaned := map[string][]string{}
+	for _, key := range slices.Sorted(maps.Keys(f)) {
+		cleanedKey := cleanKey(key)
+		if cleanedKey == key {
+			continue
+		}
+		err.Unmatchable = append(err.Unmatchable, key)
+		if _, ok := f[cleanedKey]; ok {
+			err.Duplicates = append(err.Duplicates, key)
+			continue
+		}
+		if cleanedKey != "" {
+			cleaned[cleanedKey] = append(cleaned[cleanedKey], key)
+		}
+	}
+	for _, cleanedKey := range slices.Sorted(maps.Keys(cleaned)) {
+		if len(cleaned[cleanedKey]) > 1 {
+			err.Collisions = append(err.Collisions, cleaned[cleanedKey])
+		}
+	}
+
+	if len(err.Unmatchable) == 0 {
+		return nil
+	}
+	return err
+}
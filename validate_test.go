@@ -0,0 +1,103 @@
+package legalform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	legalform "github.com/tilotech/go-company-legal-form"
+)
+
+func TestNewLegalForms(t *testing.T) {
+	actual := legalform.NewLegalForms("GmbH", "s.a.", "S A", "Iværksætterselskab", "&", "")
+	assert.Equal(t, legalform.LegalForms{
+		"gmbh":               struct{}{},
+		"sa":                 struct{}{},
+		"ivarksatterselskab": struct{}{},
+	}, actual)
+	assert.NoError(t, actual.Validate())
+
+	name, legalForm := actual.Strip("Example S.A.")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "S.A.", legalForm)
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		forms              legalform.LegalForms
+		expectedValid      bool
+		expectedUnmatched  []string
+		expectedDuplicates []string
+		expectedCollisions [][]string
+	}{
+		{
+			forms:         legalform.LegalForms{"gmbh": struct{}{}, "sa": struct{}{}},
+			expectedValid: true,
+		},
+		{
+			forms:             legalform.LegalForms{"GmbH": struct{}{}, "&": struct{}{}},
+			expectedUnmatched: []string{"&", "GmbH"},
+		},
+		{
+			forms:              legalform.LegalForms{"gmbh": struct{}{}, "GmbH": struct{}{}},
+			expectedUnmatched:  []string{"GmbH"},
+			expectedDuplicates: []string{"GmbH"},
+		},
+		{
+			forms:              legalform.LegalForms{"s.a.": struct{}{}, "S A": struct{}{}, "S.A": struct{}{}},
+			expectedUnmatched:  []string{"S A", "S.A", "s.a."},
+			expectedCollisions: [][]string{{"S A", "S.A", "s.a."}},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			err := c.forms.Validate()
+			if c.expectedValid {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *legalform.ValidationError
+			if assert.ErrorAs(t, err, &validationErr) {
+				assert.Equal(t, c.expectedUnmatched, validationErr.Unmatchable)
+				assert.Equal(t, c.expectedDuplicates, validationErr.Duplicates)
+				assert.Equal(t, c.expectedCollisions, validationErr.Collisions)
+			}
+		})
+	}
+}
+
+func TestDefaultIsValid(t *testing.T) {
+	assert.NoError(t, legalform.Default.Validate())
+}
+
+func TestDefaultNormalizedKeys(t *testing.T) {
+	// These keys of Default used to contain Greek or Danish letters that are
+	// normalized when stripping, hence they never matched.
+	cases := []struct {
+		input       string
+		expectedKey string
+		previousKey string
+	}{
+		{"Example Ε.Ο.Ο.Σ.", "eoos", "εοος"},
+		{"Example Evropaiki Etaireia", "evropaikietaireia", "εvropaikiεtaireia"},
+		{"Example Europæisk Økonomisk Firmagruppe", "europaiskokonomiskfirmagruppe", "europæiskokonomiskfirmagruppe"},
+		{"Example Europæisk Andelsselskab", "europaiskandelsselskab", "europæiskandelsselskab"},
+		{"Example Europæisk Selskab", "europaiskselskab", "europæiskselskab"},
+		{"Example Selskab med begrænset ansvar", "selskabmedbegransetansvar", "selskabmedbegrænsetansvar"},
+		{"Example Forening med begrænset ansvar", "foreningmedbegransetansvar", "foreningmedbegrænsetansvar"},
+		{"Example Andelsselskab med begrænset ansvar", "andelsselskabmedbegransetansvar", "andelsselskabmedbegrænsetansvar"},
+		{"Example Iværksætterselskab", "ivarksatterselskab", "iværksætterselskab"},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("#%v", i), func(t *testing.T) {
+			assert.Contains(t, legalform.Default, c.expectedKey)
+			assert.NotContains(t, legalform.Default, c.previousKey)
+
+			name, _ := legalform.Default.Strip(c.input)
+			assert.Equal(t, "Example", name)
+			assert.Equal(t, c.expectedKey, legalform.Default.Parse(c.input).Key)
+		})
+	}
+}